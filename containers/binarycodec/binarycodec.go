@@ -0,0 +1,308 @@
+// Package binarycodec implements the compact binary representation of the containers' elements,
+// used by their MarshalBinary and GobEncode methods.
+//
+// The representation starts with a header of the format version, the kind of the elements (values or key-value pairs)
+// and the number of elements, followed by the elements, each one a type tag followed by its length-prefixed or fixed-size payload.
+// Integers are written as varints, so small numbers take a single byte.
+//
+// Supported are nil and the values of the basic types, []byte and time.Time, which are decoded back into values of the same type.
+// Encoding values of other types fails.
+package binarycodec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"time"
+)
+
+// Version is the version of the format written by Marshal and MarshalPairs.
+const Version = 1
+
+const (
+	kindValues byte = iota
+	kindPairs
+)
+
+const (
+	tagNil byte = iota
+	tagFalse
+	tagTrue
+	tagInt
+	tagInt8
+	tagInt16
+	tagInt32
+	tagInt64
+	tagUint
+	tagUint8
+	tagUint16
+	tagUint32
+	tagUint64
+	tagFloat32
+	tagFloat64
+	tagString
+	tagBytes
+	tagTime
+)
+
+// ErrTruncated is returned when decoding data that ends before the last element.
+var ErrTruncated = errors.New("binarycodec: data is truncated")
+
+// Marshal returns the binary representation of the values of the sequence in their order.
+func Marshal[T any](seq iter.Seq[T]) ([]byte, error) {
+	var body []byte
+	count := 0
+	for value := range seq {
+		var err error
+		if body, err = appendValue(body, value); err != nil {
+			return nil, err
+		}
+		count++
+	}
+	return append(header(kindValues, count), body...), nil
+}
+
+// MarshalPairs returns the binary representation of the key-value pairs of the sequence in their order.
+func MarshalPairs(seq iter.Seq2[any, any]) ([]byte, error) {
+	var body []byte
+	count := 0
+	for key, value := range seq {
+		var err error
+		if body, err = appendValue(body, key); err != nil {
+			return nil, err
+		}
+		if body, err = appendValue(body, value); err != nil {
+			return nil, err
+		}
+		count++
+	}
+	return append(header(kindPairs, count), body...), nil
+}
+
+// Unmarshal decodes the binary representation written by Marshal into its values, preserving their order.
+// Returns an error if a value is not of type T.
+func Unmarshal[T any](data []byte) ([]T, error) {
+	count, data, err := readHeader(data, kindValues)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]T, 0, count)
+	for i := 0; i < count; i++ {
+		var value any
+		if value, data, err = readValue(data); err != nil {
+			return nil, err
+		}
+		typed, ok := value.(T)
+		if !ok && value == nil {
+			ok = any(typed) == nil // nil is a value of the interface types only
+		}
+		if !ok {
+			return nil, fmt.Errorf("binarycodec: value %v of type %T is not of the element type", value, value)
+		}
+		values = append(values, typed)
+	}
+	return values, trailing(data)
+}
+
+// UnmarshalPairs decodes the binary representation written by MarshalPairs into its keys and values, preserving their order.
+func UnmarshalPairs(data []byte) (keys []any, values []any, err error) {
+	count, data, err := readHeader(data, kindPairs)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys, values = make([]any, count), make([]any, count)
+	for i := 0; i < count; i++ {
+		if keys[i], data, err = readValue(data); err != nil {
+			return nil, nil, err
+		}
+		if values[i], data, err = readValue(data); err != nil {
+			return nil, nil, err
+		}
+	}
+	return keys, values, trailing(data)
+}
+
+func header(kind byte, count int) []byte {
+	return binary.AppendUvarint([]byte{Version, kind}, uint64(count))
+}
+
+func readHeader(data []byte, kind byte) (count int, rest []byte, err error) {
+	if len(data) < 2 {
+		return 0, nil, ErrTruncated
+	}
+	if data[0] != Version {
+		return 0, nil, fmt.Errorf("binarycodec: unsupported version %v", data[0])
+	}
+	if data[1] != kind {
+		return 0, nil, errors.New("binarycodec: data holds elements of another kind")
+	}
+	n, rest, err := readUvarint(data[2:])
+	if err != nil {
+		return 0, nil, err
+	}
+	// every element takes at least one byte, which bounds the allocations for corrupted counts
+	if n > uint64(len(rest)) {
+		return 0, nil, ErrTruncated
+	}
+	return int(n), rest, nil
+}
+
+func trailing(data []byte) error {
+	if len(data) != 0 {
+		return fmt.Errorf("binarycodec: %v bytes of trailing data", len(data))
+	}
+	return nil
+}
+
+func appendValue(data []byte, value any) ([]byte, error) {
+	switch v := value.(type) {
+	case nil:
+		return append(data, tagNil), nil
+	case bool:
+		if v {
+			return append(data, tagTrue), nil
+		}
+		return append(data, tagFalse), nil
+	case int:
+		return binary.AppendVarint(append(data, tagInt), int64(v)), nil
+	case int8:
+		return binary.AppendVarint(append(data, tagInt8), int64(v)), nil
+	case int16:
+		return binary.AppendVarint(append(data, tagInt16), int64(v)), nil
+	case int32:
+		return binary.AppendVarint(append(data, tagInt32), int64(v)), nil
+	case int64:
+		return binary.AppendVarint(append(data, tagInt64), v), nil
+	case uint:
+		return binary.AppendUvarint(append(data, tagUint), uint64(v)), nil
+	case uint8:
+		return binary.AppendUvarint(append(data, tagUint8), uint64(v)), nil
+	case uint16:
+		return binary.AppendUvarint(append(data, tagUint16), uint64(v)), nil
+	case uint32:
+		return binary.AppendUvarint(append(data, tagUint32), uint64(v)), nil
+	case uint64:
+		return binary.AppendUvarint(append(data, tagUint64), v), nil
+	case float32:
+		return binary.LittleEndian.AppendUint32(append(data, tagFloat32), math.Float32bits(v)), nil
+	case float64:
+		return binary.LittleEndian.AppendUint64(append(data, tagFloat64), math.Float64bits(v)), nil
+	case string:
+		return append(binary.AppendUvarint(append(data, tagString), uint64(len(v))), v...), nil
+	case []byte:
+		return append(binary.AppendUvarint(append(data, tagBytes), uint64(len(v))), v...), nil
+	case time.Time:
+		encoded, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append(binary.AppendUvarint(append(data, tagTime), uint64(len(encoded))), encoded...), nil
+	}
+	return nil, fmt.Errorf("binarycodec: unsupported type %T", value)
+}
+
+func readValue(data []byte) (value any, rest []byte, err error) {
+	if len(data) == 0 {
+		return nil, nil, ErrTruncated
+	}
+	tag, data := data[0], data[1:]
+	switch tag {
+	case tagNil:
+		return nil, data, nil
+	case tagFalse:
+		return false, data, nil
+	case tagTrue:
+		return true, data, nil
+	case tagInt, tagInt8, tagInt16, tagInt32, tagInt64:
+		v, rest, err := readVarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch tag {
+		case tagInt:
+			value = int(v)
+		case tagInt8:
+			value = int8(v)
+		case tagInt16:
+			value = int16(v)
+		case tagInt32:
+			value = int32(v)
+		default:
+			value = v
+		}
+		return value, rest, nil
+	case tagUint, tagUint8, tagUint16, tagUint32, tagUint64:
+		v, rest, err := readUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch tag {
+		case tagUint:
+			value = uint(v)
+		case tagUint8:
+			value = uint8(v)
+		case tagUint16:
+			value = uint16(v)
+		case tagUint32:
+			value = uint32(v)
+		default:
+			value = v
+		}
+		return value, rest, nil
+	case tagFloat32:
+		if len(data) < 4 {
+			return nil, nil, ErrTruncated
+		}
+		return math.Float32frombits(binary.LittleEndian.Uint32(data)), data[4:], nil
+	case tagFloat64:
+		if len(data) < 8 {
+			return nil, nil, ErrTruncated
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), data[8:], nil
+	case tagString, tagBytes, tagTime:
+		n, rest, err := readUvarint(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		if n > uint64(len(rest)) {
+			return nil, nil, ErrTruncated
+		}
+		payload, rest := rest[:n], rest[n:]
+		switch tag {
+		case tagString:
+			return string(payload), rest, nil
+		case tagBytes:
+			return append([]byte{}, payload...), rest, nil
+		}
+		var t time.Time
+		if err := t.UnmarshalBinary(payload); err != nil {
+			return nil, nil, err
+		}
+		return t, rest, nil
+	}
+	return nil, nil, fmt.Errorf("binarycodec: unknown type tag %v", tag)
+}
+
+func readVarint(data []byte) (int64, []byte, error) {
+	v, n := binary.Varint(data)
+	if n == 0 {
+		return 0, nil, ErrTruncated
+	}
+	if n < 0 {
+		return 0, nil, errors.New("binarycodec: varint overflows 64 bits")
+	}
+	return v, data[n:], nil
+}
+
+func readUvarint(data []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(data)
+	if n == 0 {
+		return 0, nil, ErrTruncated
+	}
+	if n < 0 {
+		return 0, nil, errors.New("binarycodec: varint overflows 64 bits")
+	}
+	return v, data[n:], nil
+}
@@ -0,0 +1,92 @@
+package binarycodec
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	values := []any{nil, false, true, -1, int8(-2), int16(300), int32(-70000), int64(1 << 40), uint(1), uint8(255), uint16(2), uint32(3), uint64(1 << 63),
+		float32(1.5), -2.25, "", "héllo", []byte{0, 1}, time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)}
+	data, err := Marshal(slices.Values(values))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded, err := Unmarshal[any](data)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%#v", decoded), fmt.Sprintf("%#v", values); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	ints, err := Unmarshal[int](mustMarshal(t, []int{1, 1000, -5}))
+	if actualValue, expectedValue := fmt.Sprint(ints, err), "[1 1000 -5] <nil>"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, err := Unmarshal[int](mustMarshal(t, []any{1, "a"})); err == nil {
+		t.Errorf("Expected error for a value of another type")
+	}
+	if _, err := Unmarshal[int](mustMarshal(t, []any{nil})); err == nil {
+		t.Errorf("Expected error for nil of a non-interface type")
+	}
+	if data := mustMarshal(t, []int{1, 2}); !bytes.Equal(data, []byte{Version, kindValues, 2, tagInt, 2, tagInt, 4}) {
+		t.Errorf("Got %v expected a compact representation", data)
+	}
+	if _, err := Marshal(slices.Values([]any{struct{}{}})); err == nil {
+		t.Errorf("Expected error for an unsupported type")
+	}
+}
+
+func TestMarshalUnmarshalPairs(t *testing.T) {
+	data, err := MarshalPairs(maps.All(map[any]any{1: "a"}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	keys, values, err := UnmarshalPairs(data)
+	if actualValue, expectedValue := fmt.Sprint(keys, values, err), "[1] [a] <nil>"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, err := Unmarshal[any](data); err == nil {
+		t.Errorf("Expected error for pairs decoded as values")
+	}
+	if _, _, err := UnmarshalPairs(mustMarshal(t, []int{1})); err == nil {
+		t.Errorf("Expected error for values decoded as pairs")
+	}
+	if _, err := MarshalPairs(maps.All(map[any]any{1: struct{}{}})); err == nil {
+		t.Errorf("Expected error for an unsupported type")
+	}
+}
+
+func TestUnmarshalCorrupted(t *testing.T) {
+	data := mustMarshal(t, []any{"abc", 1.5, int64(-1 << 60), time.Unix(0, 0)})
+	for i := 0; i < len(data); i++ {
+		if _, err := Unmarshal[any](data[:i]); err == nil {
+			t.Errorf("Expected error for data truncated to %v bytes", i)
+		}
+	}
+	for _, input := range [][]byte{
+		append(data, 0),
+		{Version + 1, kindValues, 0},
+		{Version, kindValues, 1, 255},
+		{Version, kindValues, 200, tagNil},
+		{Version, kindValues, 1, tagInt, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 1},
+		{Version, kindValues, 1, tagTime, 1, 0},
+	} {
+		if _, err := Unmarshal[any](input); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+}
+
+func mustMarshal[T any](t *testing.T, values []T) []byte {
+	data, err := Marshal(slices.Values(values))
+	if err != nil {
+		t.Fatalf("Got error %v", err)
+	}
+	return data
+}
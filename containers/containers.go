@@ -9,7 +9,11 @@
 // Serialization provides serializers (marshalers) and deserializers (unmarshalers).
 package containers
 
-import "github.com/Arafatk/Dataviz/utils"
+import (
+	"slices"
+
+	"github.com/Arafatk/Dataviz/utils"
+)
 
 // Container is base interface that all data structures implement.
 type Container[T any] interface {
@@ -26,6 +30,6 @@ func GetSortedValues[T comparable](container Container[T], comparator utils.Comp
 	if len(values) < 2 {
 		return values
 	}
-	utils.Sort(values, comparator)
+	slices.SortFunc(values, func(a, b T) int { return comparator(a, b) })
 	return values
 }
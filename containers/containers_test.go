@@ -3,6 +3,7 @@
 package containers
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/Arafatk/Dataviz/utils"
@@ -50,3 +51,96 @@ func TestGetSortedValuesStrings(t *testing.T) {
 		}
 	}
 }
+
+// For testing purposes
+type EnumerableTest[T any] []T
+
+func (enumerable EnumerableTest[T]) Each(f func(index int, value T)) {
+	for index, value := range enumerable {
+		f(index, value)
+	}
+}
+
+func (enumerable EnumerableTest[T]) Any(f func(index int, value T) bool) bool {
+	index, _ := enumerable.Find(f)
+	return index != -1
+}
+
+func (enumerable EnumerableTest[T]) All(f func(index int, value T) bool) bool {
+	return !enumerable.Any(func(index int, value T) bool { return !f(index, value) })
+}
+
+func (enumerable EnumerableTest[T]) Find(f func(index int, value T) bool) (int, T) {
+	for index, value := range enumerable {
+		if f(index, value) {
+			return index, value
+		}
+	}
+	var value T
+	return -1, value
+}
+
+// For testing purposes
+type EnumerableWithKeyTest []string
+
+func (enumerable EnumerableWithKeyTest) Each(f func(key any, value any)) {
+	for index, value := range enumerable {
+		f(index, value)
+	}
+}
+
+func (enumerable EnumerableWithKeyTest) Any(f func(key any, value any) bool) bool {
+	key, _ := enumerable.Find(f)
+	return key != nil
+}
+
+func (enumerable EnumerableWithKeyTest) All(f func(key any, value any) bool) bool {
+	return !enumerable.Any(func(key any, value any) bool { return !f(key, value) })
+}
+
+func (enumerable EnumerableWithKeyTest) Find(f func(key any, value any) bool) (any, any) {
+	for index, value := range enumerable {
+		if f(index, value) {
+			return index, value
+		}
+	}
+	return nil, nil
+}
+
+func TestFoldReduceMap(t *testing.T) {
+	enumerable := EnumerableTest[int]{1, 2, 3, 4}
+
+	sum := Fold[int](enumerable, "", func(accumulator string, index int, value int) string {
+		return fmt.Sprintf("%s%d:%d ", accumulator, index, value)
+	})
+	if actualValue, expectedValue := sum, "0:1 1:2 2:3 3:4 "; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	product, ok := Reduce[int](enumerable, func(accumulator int, value int) int { return accumulator * value })
+	if actualValue, expectedValue := product, 24; actualValue != expectedValue || !ok {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if _, ok := Reduce[int](EnumerableTest[int]{}, func(accumulator int, value int) int { return 0 }); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+
+	halves := Map[int](enumerable, func(index int, value int) float64 { return float64(value) / 2 })
+	if actualValue, expectedValue := fmt.Sprint(halves), "[0.5 1 1.5 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestFoldMapWithKey(t *testing.T) {
+	enumerable := EnumerableWithKeyTest{"a", "b", "c"}
+
+	length := FoldWithKey(enumerable, 0, func(accumulator int, key any, value any) int { return accumulator + len(value.(string)) })
+	if actualValue, expectedValue := length, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	pairs := MapWithKey(enumerable, func(key any, value any) string { return fmt.Sprintf("%v=%v", key, value) })
+	if actualValue, expectedValue := fmt.Sprint(pairs), "[0=a 1=b 2=c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -0,0 +1,353 @@
+// Package containertest implements model-based property testing of the containers.
+//
+// A byte slice, usually provided by a fuzz target, is decoded into a sequence of operations, which are applied
+// both to the container under test and to a simple reference model built from Go maps and slices.
+// After every operation the results, the size and the contents of both are compared, and the container's
+// invariant checks are run if it has any (see Validator). The first divergence is returned as an error
+// listing the operations applied so far, so that a failing sequence minimized by the fuzzing engine can be replayed by hand.
+//
+// Typical use within a container's tests:
+//
+//	func FuzzTree(f *testing.F) {
+//		containertest.AddSeeds(f)
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			if err := containertest.CheckMap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+//				t.Fatal(err)
+//			}
+//		})
+//	}
+package containertest
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// KeySpace is the number of distinct keys and values used by decoded operations.
+// It is kept small so that random sequences frequently hit existing elements.
+const KeySpace = 64
+
+// Op is a single operation of a sequence.
+// Each checker interprets the code on its own, see the checkers for the operations they perform.
+type Op struct {
+	Code byte
+	Arg  int
+}
+
+// Decode turns the data into a sequence of operations, consuming two bytes per operation.
+// A trailing odd byte is ignored.
+func Decode(data []byte) []Op {
+	ops := make([]Op, 0, len(data)/2)
+	for i := 0; i+1 < len(data); i += 2 {
+		ops = append(ops, Op{Code: data[i], Arg: int(data[i+1]) % KeySpace})
+	}
+	return ops
+}
+
+// AddSeeds adds a deterministic seed corpus of random operation sequences of various lengths to the fuzz target.
+// The seeds are also run as regular tests by go test.
+func AddSeeds(f *testing.F) {
+	random := rand.New(rand.NewSource(1))
+	f.Add([]byte{})
+	for _, length := range []int{2, 16, 64, 256, 1024, 4096} {
+		data := make([]byte, length)
+		random.Read(data)
+		f.Add(data)
+	}
+}
+
+// Validator is implemented by containers able to check their structural invariants.
+type Validator interface {
+	Validate() error
+}
+
+// Map is the behaviour of an ordered map with int keys and values checked by CheckMap.
+type Map interface {
+	Put(key any, value any)
+	Get(key any) (value any, found bool)
+	Remove(key any)
+	Empty() bool
+	Size() int
+	Keys() []any
+	Values() []any
+	Clear()
+}
+
+// CheckMap applies the operations to the map and to a reference model and returns an error on the first divergence.
+// The map is expected to order its keys with an int comparator.
+//
+// Operations are put (most codes), remove, get and, rarely, clear with the argument as key.
+func CheckMap(m Map, ops []Op) error {
+	model := map[int]int{}
+	var trace trace
+	for i, op := range ops {
+		switch {
+		case op.Code == 255:
+			trace.add("Clear()")
+			m.Clear()
+			model = map[int]int{}
+		case op.Code%4 == 0:
+			trace.add("Remove(%v)", op.Arg)
+			m.Remove(op.Arg)
+			delete(model, op.Arg)
+		case op.Code%4 == 1:
+			trace.add("Get(%v)", op.Arg)
+			value, found := m.Get(op.Arg)
+			expected, expectedFound := model[op.Arg]
+			if found != expectedFound || (found && value != expected) {
+				return trace.errorf("got %v, %v expected %v, %v", value, found, expected, expectedFound)
+			}
+		default:
+			trace.add("Put(%v, %v)", op.Arg, i)
+			m.Put(op.Arg, i)
+			model[op.Arg] = i
+		}
+		if err := validate(m); err != nil {
+			return trace.errorf("%v", err)
+		}
+		if m.Size() != len(model) || m.Empty() != (len(model) == 0) {
+			return trace.errorf("got size %v expected %v", m.Size(), len(model))
+		}
+	}
+	keys := make([]int, 0, len(model))
+	for key := range model {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	values := make([]int, len(keys))
+	for i, key := range keys {
+		values[i] = model[key]
+	}
+	if actual, expected := fmt.Sprint(m.Keys()), fmt.Sprint(keys); actual != expected {
+		return trace.errorf("got keys %v expected %v", actual, expected)
+	}
+	if actual, expected := fmt.Sprint(m.Values()), fmt.Sprint(values); actual != expected {
+		return trace.errorf("got values %v expected %v", actual, expected)
+	}
+	return nil
+}
+
+// List is the behaviour of a list of ints checked by CheckList.
+type List interface {
+	Add(values ...int)
+	Get(index int) (int, bool)
+	Remove(index int) int
+	Insert(index int, values ...int)
+	Swap(i, j int)
+	Contains(values ...int) bool
+	Values() []int
+	Empty() bool
+	Size() int
+	Clear()
+}
+
+// CheckList applies the operations to the list and to a reference slice and returns an error on the first divergence.
+//
+// Operations are add (most codes), insert, remove, get, swap, contains and, rarely, clear,
+// indices are derived from the argument modulo the size of the list plus one, so that out of range indices are exercised too.
+func CheckList(list List, ops []Op) error {
+	model := []int{}
+	var trace trace
+	for i, op := range ops {
+		index := op.Arg % (len(model) + 1)
+		switch {
+		case op.Code == 255:
+			trace.add("Clear()")
+			list.Clear()
+			model = model[:0]
+		case op.Code%8 == 0:
+			trace.add("Insert(%v, %v)", index, i)
+			list.Insert(index, i)
+			model = append(model[:index], append([]int{i}, model[index:]...)...)
+		case op.Code%8 == 1:
+			trace.add("Remove(%v)", index)
+			list.Remove(index)
+			if index < len(model) {
+				model = append(model[:index], model[index+1:]...)
+			}
+		case op.Code%8 == 2:
+			trace.add("Get(%v)", index)
+			value, ok := list.Get(index)
+			if ok != (index < len(model)) || (ok && value != model[index]) {
+				return trace.errorf("got %v, %v", value, ok)
+			}
+		case op.Code%8 == 3:
+			other := int(op.Code) % (len(model) + 1)
+			trace.add("Swap(%v, %v)", index, other)
+			list.Swap(index, other)
+			if index < len(model) && other < len(model) {
+				model[index], model[other] = model[other], model[index]
+			}
+		case op.Code%8 == 4:
+			trace.add("Contains(%v)", op.Arg)
+			expected := false
+			for _, value := range model {
+				expected = expected || value == op.Arg
+			}
+			if actual := list.Contains(op.Arg); actual != expected {
+				return trace.errorf("got %v expected %v", actual, expected)
+			}
+		default:
+			trace.add("Add(%v)", i)
+			list.Add(i)
+			model = append(model, i)
+		}
+		if err := validate(list); err != nil {
+			return trace.errorf("%v", err)
+		}
+		if list.Size() != len(model) || list.Empty() != (len(model) == 0) {
+			return trace.errorf("got size %v expected %v", list.Size(), len(model))
+		}
+	}
+	if actual, expected := fmt.Sprint(list.Values()), fmt.Sprint(model); actual != expected {
+		return trace.errorf("got values %v expected %v", actual, expected)
+	}
+	return nil
+}
+
+// Stack is the behaviour of a LIFO stack checked by CheckStack.
+type Stack interface {
+	Push(value any)
+	Pop() (value any, ok bool)
+	Peek() (value any, ok bool)
+	Empty() bool
+	Size() int
+	Values() []any
+	Clear()
+}
+
+// CheckStack applies the operations to the stack and to a reference slice and returns an error on the first divergence.
+// Values of the stack are expected from top to bottom.
+//
+// Operations are push (most codes), pop, peek and, rarely, clear.
+func CheckStack(stack Stack, ops []Op) error {
+	model := []int{}
+	var trace trace
+	for _, op := range ops {
+		switch {
+		case op.Code == 255:
+			trace.add("Clear()")
+			stack.Clear()
+			model = model[:0]
+		case op.Code%3 == 0, op.Code%3 == 1:
+			pop := op.Code%3 == 0
+			var value any
+			var ok bool
+			if pop {
+				trace.add("Pop()")
+				value, ok = stack.Pop()
+			} else {
+				trace.add("Peek()")
+				value, ok = stack.Peek()
+			}
+			if ok != (len(model) > 0) || (ok && value != model[len(model)-1]) {
+				return trace.errorf("got %v, %v", value, ok)
+			}
+			if pop && ok {
+				model = model[:len(model)-1]
+			}
+		default:
+			trace.add("Push(%v)", op.Arg)
+			stack.Push(op.Arg)
+			model = append(model, op.Arg)
+		}
+		if err := validate(stack); err != nil {
+			return trace.errorf("%v", err)
+		}
+		if stack.Size() != len(model) || stack.Empty() != (len(model) == 0) {
+			return trace.errorf("got size %v expected %v", stack.Size(), len(model))
+		}
+	}
+	expected := make([]int, len(model))
+	for i, value := range model {
+		expected[len(model)-1-i] = value
+	}
+	if actual := fmt.Sprint(stack.Values()); actual != fmt.Sprint(expected) {
+		return trace.errorf("got values %v expected %v", actual, expected)
+	}
+	return nil
+}
+
+// Heap is the behaviour of a priority queue of ints checked by CheckHeap.
+type Heap interface {
+	Push(values ...any)
+	Pop() (value any, ok bool)
+	Peek() (value any, ok bool)
+	Empty() bool
+	Size() int
+	Clear()
+}
+
+// CheckHeap applies the operations to the heap and to a reference slice and returns an error on the first divergence.
+// The heap is expected to order its values with an int comparator, i.e. to pop the smallest value first.
+//
+// Operations are push (most codes), bulk push of several values, pop, peek and, rarely, clear.
+func CheckHeap(heap Heap, ops []Op) error {
+	model := []int{}
+	var trace trace
+	for i, op := range ops {
+		switch {
+		case op.Code == 255:
+			trace.add("Clear()")
+			heap.Clear()
+			model = model[:0]
+		case op.Code%4 == 0, op.Code%4 == 1:
+			pop := op.Code%4 == 0
+			var value any
+			var ok bool
+			if pop {
+				trace.add("Pop()")
+				value, ok = heap.Pop()
+			} else {
+				trace.add("Peek()")
+				value, ok = heap.Peek()
+			}
+			sort.Ints(model)
+			if ok != (len(model) > 0) || (ok && value != model[0]) {
+				return trace.errorf("got %v, %v", value, ok)
+			}
+			if pop && ok {
+				model = model[1:]
+			}
+		case op.Code%4 == 2:
+			values := []any{op.Arg, (op.Arg + i) % KeySpace, (op.Arg * i) % KeySpace}
+			trace.add("Push(%v, %v, %v)", values...)
+			heap.Push(values...)
+			for _, value := range values {
+				model = append(model, value.(int))
+			}
+		default:
+			trace.add("Push(%v)", op.Arg)
+			heap.Push(op.Arg)
+			model = append(model, op.Arg)
+		}
+		if err := validate(heap); err != nil {
+			return trace.errorf("%v", err)
+		}
+		if heap.Size() != len(model) || heap.Empty() != (len(model) == 0) {
+			return trace.errorf("got size %v expected %v", heap.Size(), len(model))
+		}
+	}
+	return nil
+}
+
+// trace records the operations applied so far for error messages.
+type trace []string
+
+func (trace *trace) add(format string, args ...any) {
+	*trace = append(*trace, fmt.Sprintf(format, args...))
+}
+
+func (trace trace) errorf(format string, args ...any) error {
+	return fmt.Errorf("after %v: %v", strings.Join(trace, "; "), fmt.Sprintf(format, args...))
+}
+
+func validate(container any) error {
+	if validator, ok := container.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
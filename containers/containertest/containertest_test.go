@@ -0,0 +1,129 @@
+package containertest
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// sliceMap is a trivially correct Map used to test the checker itself.
+type sliceMap struct {
+	m          map[int]any
+	skipRemove bool
+}
+
+func (m *sliceMap) Put(key any, value any) { m.m[key.(int)] = value }
+func (m *sliceMap) Get(key any) (any, bool) {
+	value, found := m.m[key.(int)]
+	return value, found
+}
+func (m *sliceMap) Remove(key any) {
+	if !m.skipRemove {
+		delete(m.m, key.(int))
+	}
+}
+func (m *sliceMap) Empty() bool { return len(m.m) == 0 }
+func (m *sliceMap) Size() int   { return len(m.m) }
+func (m *sliceMap) Clear()      { m.m = map[int]any{} }
+func (m *sliceMap) sortedKeys() []int {
+	keys := []int{}
+	for key := range m.m {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return keys
+}
+func (m *sliceMap) Keys() []any {
+	keys := []any{}
+	for _, key := range m.sortedKeys() {
+		keys = append(keys, key)
+	}
+	return keys
+}
+func (m *sliceMap) Values() []any {
+	values := []any{}
+	for _, key := range m.sortedKeys() {
+		values = append(values, m.m[key])
+	}
+	return values
+}
+
+// sliceStack is a trivially correct Stack used to test the checker itself.
+type sliceStack struct {
+	values  []any
+	invalid bool
+}
+
+func (s *sliceStack) Push(value any) { s.values = append(s.values, value) }
+func (s *sliceStack) Pop() (any, bool) {
+	value, ok := s.Peek()
+	if ok {
+		s.values = s.values[:len(s.values)-1]
+	}
+	return value, ok
+}
+func (s *sliceStack) Peek() (any, bool) {
+	if len(s.values) == 0 {
+		return nil, false
+	}
+	return s.values[len(s.values)-1], true
+}
+func (s *sliceStack) Empty() bool { return len(s.values) == 0 }
+func (s *sliceStack) Size() int   { return len(s.values) }
+func (s *sliceStack) Clear()      { s.values = nil }
+func (s *sliceStack) Values() []any {
+	values := make([]any, len(s.values))
+	for i, value := range s.values {
+		values[len(s.values)-1-i] = value
+	}
+	return values
+}
+func (s *sliceStack) Validate() error {
+	if s.invalid && len(s.values) > 2 {
+		return errInvalid
+	}
+	return nil
+}
+
+var errInvalid = errors.New("stack is invalid")
+
+func TestDecode(t *testing.T) {
+	ops := Decode([]byte{1, 2, 3, 200, 5})
+	if len(ops) != 2 {
+		t.Fatalf("Got %v expected %v operations", len(ops), 2)
+	}
+	if ops[0] != (Op{1, 2}) || ops[1] != (Op{3, 200 % KeySpace}) {
+		t.Errorf("Got %v", ops)
+	}
+}
+
+func TestCheckMap(t *testing.T) {
+	ops := Decode([]byte{2, 1, 2, 2, 3, 5, 1, 1, 0, 1, 1, 1, 2, 7})
+	if err := CheckMap(&sliceMap{m: map[int]any{}}, ops); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	err := CheckMap(&sliceMap{m: map[int]any{}, skipRemove: true}, ops)
+	if err == nil || !strings.Contains(err.Error(), "Put(1, 0); Put(2, 1); Put(5, 2); Get(1); Remove(1)") {
+		t.Errorf("Got %v expected the failing sequence", err)
+	}
+}
+
+func TestCheckStack(t *testing.T) {
+	ops := Decode([]byte{2, 1, 2, 2, 1, 0, 0, 0, 2, 3, 2, 4, 2, 5})
+	if err := CheckStack(&sliceStack{}, ops); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	if err := CheckStack(&sliceStack{invalid: true}, ops); err == nil || !strings.Contains(err.Error(), "stack is invalid") {
+		t.Errorf("Got %v expected the validation error", err)
+	}
+}
+
+func FuzzCheckMap(f *testing.F) {
+	AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := CheckMap(&sliceMap{m: map[int]any{}}, Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
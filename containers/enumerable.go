@@ -55,3 +55,56 @@ type EnumerableWithKey interface {
 	// matches the criteria.
 	Find(func(key any, value any) bool) (any, any)
 }
+
+// Fold calls the given function once for each element, passing the result of the previous call (initial for the first element)
+// along with that element's index and value, and returns the result of the last call, or initial if there are no elements.
+// The result can be of a different type than the elements.
+func Fold[V, A any](enumerable EnumerableWithIndex[V], initial A, f func(accumulator A, index int, value V) A) A {
+	accumulator := initial
+	enumerable.Each(func(index int, value V) {
+		accumulator = f(accumulator, index, value)
+	})
+	return accumulator
+}
+
+// Reduce combines the elements in order with the given function, starting from the first element.
+// Second return parameter is false if there are no elements.
+func Reduce[V any](enumerable EnumerableWithIndex[V], f func(accumulator V, value V) V) (result V, ok bool) {
+	enumerable.Each(func(index int, value V) {
+		if !ok {
+			result, ok = value, true
+			return
+		}
+		result = f(result, value)
+	})
+	return result, ok
+}
+
+// Map invokes the given function once for each element and returns the values returned by the given function in order.
+// Unlike the Map methods of the containers, the values can be of a different type than the elements.
+func Map[V, U any](enumerable EnumerableWithIndex[V], f func(index int, value V) U) []U {
+	var values []U
+	enumerable.Each(func(index int, value V) {
+		values = append(values, f(index, value))
+	})
+	return values
+}
+
+// FoldWithKey calls the given function once for each element, passing the result of the previous call (initial for the first element)
+// along with that element's key and value, and returns the result of the last call, or initial if there are no elements.
+func FoldWithKey[A any](enumerable EnumerableWithKey, initial A, f func(accumulator A, key any, value any) A) A {
+	accumulator := initial
+	enumerable.Each(func(key any, value any) {
+		accumulator = f(accumulator, key, value)
+	})
+	return accumulator
+}
+
+// MapWithKey invokes the given function once for each element and returns the values returned by the given function in order.
+func MapWithKey[U any](enumerable EnumerableWithKey, f func(key any, value any) U) []U {
+	var values []U
+	enumerable.Each(func(key any, value any) {
+		values = append(values, f(key, value))
+	})
+	return values
+}
@@ -0,0 +1,128 @@
+// Package jsonstream implements streaming JSON encoding and decoding of the containers' elements,
+// used by their WriteJSON and ReadJSON methods.
+//
+// Elements are written one at a time straight from the containers' sequences, so the JSON representation of a container
+// is never held in memory as a whole. As encoding/json offers no token-level encoder, every element is still encoded with json.Marshal,
+// holding the encoding of one element at a time; a json.Encoder would do the same and append a newline to every element.
+// Elements are read one at a time with the tokens of a json.Decoder, but ReadArray and ReadElements return all of them as slices,
+// from which the containers are then bulk-loaded, so reading holds a copy of the elements but never the input as a whole.
+// The output is the same JSON as the one of the containers' ToJSON methods.
+package jsonstream
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+// WriteArray writes the JSON array of the values of the sequence in their order to the writer.
+func WriteArray[T any](w io.Writer, seq iter.Seq[T]) error {
+	writer := bufio.NewWriter(w)
+	separator := byte('[')
+	for value := range seq {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		writer.WriteByte(separator)
+		writer.Write(data)
+		separator = ','
+	}
+	if separator == '[' {
+		writer.WriteByte(separator)
+	}
+	writer.WriteByte(']')
+	return writer.Flush()
+}
+
+// ReadArray reads a JSON array from the reader and returns its values in their order.
+func ReadArray[T any](r io.Reader) ([]T, error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	if token != json.Delim('[') {
+		return nil, errors.New("jsonstream: expected a JSON array")
+	}
+	values := []T{}
+	for decoder.More() {
+		var value T
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// WriteElements writes the key-value pairs of the sequence in their order to the writer.
+// Without a codec, they are written as a JSON object with the keys converted to strings,
+// otherwise as a JSON array of pairs with the keys encoded by the codec (see keycodec.Encode).
+func WriteElements(w io.Writer, codec keycodec.KeyCodec, seq iter.Seq2[any, any]) error {
+	if codec != nil {
+		return keycodec.Encode(w, codec, seq)
+	}
+	writer := bufio.NewWriter(w)
+	separator := byte('{')
+	for key, value := range seq {
+		keyData, err := json.Marshal(utils.ToString(key))
+		if err != nil {
+			return err
+		}
+		valueData, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		writer.WriteByte(separator)
+		writer.Write(keyData)
+		writer.WriteByte(':')
+		writer.Write(valueData)
+		separator = ','
+	}
+	if separator == '{' {
+		writer.WriteByte(separator)
+	}
+	writer.WriteByte('}')
+	return writer.Flush()
+}
+
+// ReadElements reads the key-value pairs written by WriteElements from the reader and returns their keys and values in their order.
+// The keys of a JSON object are returned as strings, the keys of a JSON array of pairs are decoded by the codec.
+func ReadElements(r io.Reader, codec keycodec.KeyCodec) (keys []any, values []any, err error) {
+	decoder := json.NewDecoder(r)
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	switch token {
+	case json.Delim('['):
+		return keycodec.Decode(decoder, codec)
+	case json.Delim('{'):
+	default:
+		return nil, nil, errors.New("jsonstream: expected a JSON object or array")
+	}
+	for decoder.More() {
+		if token, err = decoder.Token(); err != nil {
+			return nil, nil, err
+		}
+		var value any
+		if err = decoder.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, token.(string))
+		values = append(values, value)
+	}
+	if _, err = decoder.Token(); err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
@@ -0,0 +1,92 @@
+package jsonstream
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+func TestArray(t *testing.T) {
+	for _, values := range [][]any{{}, {1, "a", nil, []any{1.5}}} {
+		var buffer bytes.Buffer
+		if err := WriteArray(&buffer, slices.Values(values)); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		expected, _ := json.Marshal(values)
+		if actualValue, expectedValue := buffer.String(), string(expected); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	ints, err := ReadArray[int](strings.NewReader(` [1, 2,3] `))
+	if actualValue, expectedValue := fmt.Sprint(ints, err), "[1 2 3] <nil>"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, input := range []string{``, `{}`, `[1,"a"]`, `[1,2`} {
+		if _, err := ReadArray[int](strings.NewReader(input)); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+	if err := WriteArray(&bytes.Buffer{}, slices.Values([]any{func() {}})); err == nil {
+		t.Errorf("Expected error for a value that cannot be marshaled")
+	}
+}
+
+func TestElements(t *testing.T) {
+	elements := func(yield func(key any, value any) bool) {
+		_ = yield(2, "b") && yield(10, []int{1}) && yield("x", nil)
+	}
+
+	var buffer bytes.Buffer
+	if err := WriteElements(&buffer, nil, elements); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := buffer.String(), `{"2":"b","10":[1],"x":null}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys, values, err := ReadElements(&buffer, nil)
+	if actualValue, expectedValue := fmt.Sprintf("%#v %v %v", keys, values, err), `[]interface {}{"2", "10", "x"} [b [1] <nil>] <nil>`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	buffer.Reset()
+	if err := WriteElements(&buffer, keycodec.Typed, elements); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	keys, _, err = ReadElements(&buffer, keycodec.Typed)
+	if actualValue, expectedValue := fmt.Sprintf("%#v %v", keys, err), `[]interface {}{2, 10, "x"} <nil>`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	buffer.Reset()
+	if err := WriteElements(&buffer, nil, maps.All(map[any]any{})); err != nil || buffer.String() != "{}" {
+		t.Errorf("Got %v,%v expected %v", buffer.String(), err, "{}")
+	}
+	for _, input := range []string{``, `1`, `{"a":1`, `{"a":}`, `[{"key":1}]`} {
+		if _, _, err := ReadElements(strings.NewReader(input), keycodec.JSON[string]{}); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
+
+func TestWriteError(t *testing.T) {
+	if err := WriteArray(failingWriter{}, slices.Values([]int{1})); err == nil {
+		t.Errorf("Expected error of the writer")
+	}
+	if err := WriteElements(failingWriter{}, nil, maps.All(map[any]any{1: 1})); err == nil {
+		t.Errorf("Expected error of the writer")
+	}
+}
@@ -0,0 +1,255 @@
+// Package keycodec implements type-preserving JSON serialization of the keys of the key/value containers.
+//
+// A JSON object can only have string keys, so the containers' default JSON representation loses the type of the keys
+// and FromJSON restores them as strings. With a KeyCodec set, the containers are serialized as an ordered array of pairs instead:
+//
+//	[{"key":1,"value":"a"},{"key":2,"value":"b"}]
+//
+// where every key is encoded by the codec, e.g. as a plain JSON value by JSON or together with its type by Typed:
+//
+//	[{"key":{"type":"int","value":1},"value":"a"}]
+package keycodec
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"runtime"
+	"time"
+
+	"github.com/Arafatk/Dataviz/utils"
+)
+
+// KeyCodec encodes keys into JSON and decodes them back into keys of the same type.
+type KeyCodec interface {
+	// EncodeKey returns the JSON representation of the key.
+	EncodeKey(key any) (json.RawMessage, error)
+
+	// DecodeKey returns the key represented by the JSON data.
+	DecodeKey(data json.RawMessage) (any, error)
+}
+
+// JSON encodes keys as plain JSON values and decodes them into values of type K.
+// It suits containers whose keys are all of the same type, e.g. JSON[int]{} for trees with the IntComparator.
+type JSON[K any] struct{}
+
+// EncodeKey returns the JSON representation of the key.
+func (JSON[K]) EncodeKey(key any) (json.RawMessage, error) {
+	return json.Marshal(key)
+}
+
+// DecodeKey returns the key of type K represented by the JSON data.
+func (JSON[K]) DecodeKey(data json.RawMessage) (any, error) {
+	var key K
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Typed encodes keys of the basic types and time.Time as a JSON object holding the name of the type along with the value,
+// e.g. {"type":"int8","value":1}, and decodes them back into keys of that type.
+// It suits containers whose keys are of mixed types. Keys of other types cannot be encoded.
+var Typed KeyCodec = typed{}
+
+type typed struct{}
+
+type typedKey struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// decoders maps the names of the types supported by Typed to the codecs of these types.
+var decoders = map[string]KeyCodec{
+	"string":  JSON[string]{},
+	"bool":    JSON[bool]{},
+	"int":     JSON[int]{},
+	"int8":    JSON[int8]{},
+	"int16":   JSON[int16]{},
+	"int32":   JSON[int32]{},
+	"int64":   JSON[int64]{},
+	"uint":    JSON[uint]{},
+	"uint8":   JSON[uint8]{},
+	"uint16":  JSON[uint16]{},
+	"uint32":  JSON[uint32]{},
+	"uint64":  JSON[uint64]{},
+	"float32": JSON[float32]{},
+	"float64": JSON[float64]{},
+	"time":    JSON[time.Time]{},
+}
+
+func (typed) EncodeKey(key any) (json.RawMessage, error) {
+	var name string
+	switch key.(type) {
+	case string:
+		name = "string"
+	case bool:
+		name = "bool"
+	case int:
+		name = "int"
+	case int8:
+		name = "int8"
+	case int16:
+		name = "int16"
+	case int32:
+		name = "int32"
+	case int64:
+		name = "int64"
+	case uint:
+		name = "uint"
+	case uint8:
+		name = "uint8"
+	case uint16:
+		name = "uint16"
+	case uint32:
+		name = "uint32"
+	case uint64:
+		name = "uint64"
+	case float32:
+		name = "float32"
+	case float64:
+		name = "float64"
+	case time.Time:
+		name = "time"
+	default:
+		return nil, fmt.Errorf("keycodec: unsupported key type %T", key)
+	}
+	value, err := json.Marshal(key)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(typedKey{Type: name, Value: value})
+}
+
+func (typed) DecodeKey(data json.RawMessage) (any, error) {
+	var key typedKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, err
+	}
+	decoder, ok := decoders[key.Type]
+	if !ok {
+		return nil, fmt.Errorf("keycodec: unsupported key type %q", key.Type)
+	}
+	return decoder.DecodeKey(key.Value)
+}
+
+type pair struct {
+	Key   json.RawMessage `json:"key"`
+	Value any             `json:"value"`
+}
+
+// Marshal returns the JSON array of the pairs of the sequence in their order, with the keys encoded by the codec.
+func Marshal(codec KeyCodec, seq iter.Seq2[any, any]) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := Encode(&buffer, codec, seq); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// Unmarshal decodes a JSON array of pairs into its keys, decoded by the codec, and values, preserving their order.
+func Unmarshal(codec KeyCodec, data []byte) (keys []any, values []any, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if token != json.Delim('[') {
+		return nil, nil, errors.New("keycodec: expected a JSON array")
+	}
+	if keys, values, err = Decode(decoder, codec); err != nil {
+		return nil, nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, nil, errors.New("keycodec: unexpected data after the JSON array")
+	}
+	return keys, values, nil
+}
+
+// Encode writes the JSON array of the pairs of the sequence in their order to the writer, with the keys encoded by the codec.
+// The pairs are encoded and written one at a time, so the array is never held in memory as a whole.
+func Encode(w io.Writer, codec KeyCodec, seq iter.Seq2[any, any]) error {
+	writer := bufio.NewWriter(w)
+	separator := byte('[')
+	for key, value := range seq {
+		encoded, err := codec.EncodeKey(key)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(pair{Key: encoded, Value: value})
+		if err != nil {
+			return err
+		}
+		writer.WriteByte(separator)
+		writer.Write(data)
+		separator = ','
+	}
+	if separator == '[' {
+		writer.WriteByte(separator)
+	}
+	writer.WriteByte(']')
+	return writer.Flush()
+}
+
+// Decode reads the pairs of a JSON array, whose opening bracket was already read from the decoder, up to its closing bracket,
+// and returns their keys, decoded by the codec, and values, preserving their order.
+func Decode(decoder *json.Decoder, codec KeyCodec) (keys []any, values []any, err error) {
+	for i := 0; decoder.More(); i++ {
+		var pair pair
+		if err := decoder.Decode(&pair); err != nil {
+			return nil, nil, err
+		}
+		if pair.Key == nil {
+			return nil, nil, fmt.Errorf("keycodec: pair %d has no key", i)
+		}
+		key, err := codec.DecodeKey(pair.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+		values = append(values, pair.Value)
+	}
+	if _, err := decoder.Token(); err != nil {
+		return nil, nil, err
+	}
+	return keys, values, nil
+}
+
+// CheckKeys returns an error if the comparator cannot compare the decoded keys, e.g. keys decoded by a KeyCodec or the binarycodec package.
+// The keys of a JSON object are decoded as strings, so unless the elements were written with a codec,
+// they cannot be loaded into a container whose comparator expects keys of another type, e.g. the IntComparator.
+// The comparator is called once for every type of the keys, and only a failed type assertion is reported as an error,
+// any other panic of the comparator is not recovered.
+func CheckKeys(keys []any, comparator utils.Comparator) error {
+	checked := make(map[reflect.Type]bool)
+	for _, key := range keys {
+		keyType := reflect.TypeOf(key)
+		if checked[keyType] {
+			continue
+		}
+		checked[keyType] = true
+		if err := checkKey(key, comparator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkKey returns an error if the comparator fails a type assertion comparing the key with itself.
+func checkKey(key any, comparator utils.Comparator) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(*runtime.TypeAssertionError); !ok {
+				panic(r)
+			}
+			err = fmt.Errorf("keycodec: comparator cannot compare keys of type %T, a key codec is needed to restore keys of other types than string: %v", key, r)
+		}
+	}()
+	comparator(key, key)
+	return nil
+}
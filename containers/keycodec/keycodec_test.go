@@ -0,0 +1,91 @@
+package keycodec
+
+import (
+	"fmt"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/Arafatk/Dataviz/utils"
+)
+
+func TestTyped(t *testing.T) {
+	keys := []any{"a", true, 1, int8(-2), int16(3), int32(4), int64(5), uint(6), uint8(7), uint16(8), uint32(9), uint64(10),
+		float32(1.5), 2.5, time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)}
+	for _, key := range keys {
+		data, err := Typed.EncodeKey(key)
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		decoded, err := Typed.DecodeKey(data)
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		if actualValue, expectedValue := fmt.Sprintf("%T(%v)", decoded, decoded), fmt.Sprintf("%T(%v)", key, key); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	if data, _ := Typed.EncodeKey(int8(1)); string(data) != `{"type":"int8","value":1}` {
+		t.Errorf("Got %s expected %v", data, `{"type":"int8","value":1}`)
+	}
+	if _, err := Typed.EncodeKey(struct{}{}); err == nil {
+		t.Errorf("Expected error for an unsupported key type")
+	}
+	if _, err := Typed.DecodeKey([]byte(`{"type":"complex","value":1}`)); err == nil {
+		t.Errorf("Expected error for an unsupported key type")
+	}
+	if _, err := Typed.DecodeKey([]byte(`{"type":"int8","value":1000}`)); err == nil {
+		t.Errorf("Expected error for an overflowing key")
+	}
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	data, err := Marshal(JSON[string]{}, maps.All(map[any]any{"a": 1}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `[{"key":"a","value":1}]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if data, _ := Marshal(Typed, maps.All(map[any]any{})); string(data) != "[]" {
+		t.Errorf("Got %s expected %v", data, "[]")
+	}
+	if _, err := Marshal(Typed, maps.All(map[any]any{struct{}{}: 1})); err == nil {
+		t.Errorf("Expected error for an unsupported key type")
+	}
+
+	keys, values, err := Unmarshal(JSON[int]{}, []byte(` [{"key":2,"value":"b"},{"key":1,"value":{"x":[1]}}]`))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%#v %v", keys, values), "[]interface {}{2, 1} [b map[x:[1]]]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	for _, input := range []string{`null`, `{}`, `[1]`, `[] 1`, `[{"value":1}]`, `[{"key":"a","value":1}]`} {
+		if _, _, err := Unmarshal(JSON[int]{}, []byte(input)); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+}
+
+func TestCheckKeys(t *testing.T) {
+	if err := CheckKeys([]any{"1", "2"}, utils.StringComparator); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := CheckKeys([]any{}, utils.IntComparator); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := CheckKeys([]any{1, "2"}, utils.IntComparator); err == nil {
+		t.Errorf("Expected error for a string key compared by the IntComparator")
+	}
+
+	// a panic other than a failed type assertion is not reported as a key type mismatch
+	defer func() {
+		if actualValue := recover(); actualValue != "comparator" {
+			t.Errorf("Got %v expected %v", actualValue, "comparator")
+		}
+	}()
+	CheckKeys([]any{1}, func(a, b any) int { panic("comparator") })
+}
@@ -0,0 +1,89 @@
+// Package seq adapts the stateful iterators of the containers to range-over-func sequences.
+//
+// The containers provide sequences of their own through their Seq, SeqKeys, SeqValues and Backward methods
+// (the names All, Keys and Values are taken by Enumerable and Container), these adapters cover any other
+// iterator implementing the interfaces of the containers package:
+//
+//	it := tree.Iterator()
+//	for key, value := range seq.WithKey(&it) {
+//		...
+//	}
+//
+// A sequence returned by an adapter resets the iterator with Begin or End every time it is ranged over,
+// so it can be ranged over repeatedly but not concurrently or in nested loops.
+//
+// The Seq and Backward methods of the containers with fail-fast iterators panic with the container's
+// ErrConcurrentModification if it is modified during the range loop, rather than ending the loop early.
+package seq
+
+import (
+	"iter"
+
+	"github.com/Arafatk/Dataviz/containers"
+)
+
+// WithIndex returns a sequence of the indices and values of the iterator, from the first to the last element.
+func WithIndex(it containers.IteratorWithIndex) iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		for it.Begin(); it.Next(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// WithKey returns a sequence of the keys and values of the iterator, from the first to the last element.
+func WithKey(it containers.IteratorWithKey) iter.Seq2[any, any] {
+	return func(yield func(any, any) bool) {
+		for it.Begin(); it.Next(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// BackwardWithIndex returns a sequence of the indices and values of the iterator, from the last to the first element.
+func BackwardWithIndex(it containers.ReverseIteratorWithIndex) iter.Seq2[int, any] {
+	return func(yield func(int, any) bool) {
+		for it.End(); it.Prev(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// BackwardWithKey returns a sequence of the keys and values of the iterator, from the last to the first element.
+func BackwardWithKey(it containers.ReverseIteratorWithKey) iter.Seq2[any, any] {
+	return func(yield func(any, any) bool) {
+		for it.End(); it.Prev(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns a sequence of the first elements of the pairs of the sequence, e.g. the keys of a map's sequence.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range seq {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the second elements of the pairs of the sequence, e.g. the values of a list's sequence.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range seq {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package seq_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+	"github.com/riadafridishibly/DataViz/stacks/arraystack"
+	"github.com/riadafridishibly/DataViz/trees/redblacktree"
+)
+
+func TestWithIndex(t *testing.T) {
+	stack := arraystack.New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	it := stack.Iterator()
+
+	actual := []string{}
+	for index, value := range seq.WithIndex(&it) {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:c 1:b 2:a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for index, value := range seq.BackwardWithIndex(&it) {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+		if index == 1 {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:a 1:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// the iterator is reset, so the sequence can be ranged over again
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(seq.Values(seq.WithIndex(&it)))), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestWithKey(t *testing.T) {
+	tree := redblacktree.NewWithIntComparator()
+	for i := 5; i > 0; i-- {
+		tree.Put(i, fmt.Sprint(i*10))
+	}
+	it := tree.Iterator()
+
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(seq.Keys(seq.WithKey(&it)))), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(seq.Values(seq.BackwardWithKey(&it)))), "[50 40 30 20 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for key, value := range seq.WithKey(&it) {
+		if key == 3 {
+			break
+		}
+		if value != fmt.Sprint(key.(int)*10) {
+			t.Errorf("Got %v expected %v", value, key.(int)*10)
+		}
+	}
+	if it.Key() != 3 {
+		t.Errorf("Got %v expected %v", it.Key(), 3)
+	}
+}
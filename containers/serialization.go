@@ -11,3 +11,15 @@ type JSONDeserializer interface {
 	// FromJSON populates containers's elements from the input JSON representation.
 	FromJSON([]byte) error
 }
+
+// BinarySerializer provides binary serialization
+type BinarySerializer interface {
+	// MarshalBinary outputs the binary representation of containers's elements (see encoding.BinaryMarshaler).
+	MarshalBinary() ([]byte, error)
+}
+
+// BinaryDeserializer provides binary deserialization
+type BinaryDeserializer interface {
+	// UnmarshalBinary populates containers's elements from the input binary representation (see encoding.BinaryUnmarshaler).
+	UnmarshalBinary([]byte) error
+}
@@ -0,0 +1,267 @@
+// Package stream implements lazy pipelines over the elements of the containers.
+//
+// Unlike the Enumerable functions of the containers, which build a new container at every step,
+// the stages of a pipeline are range-over-func sequences evaluated one element at a time when the pipeline is ranged over,
+// so that large containers can be processed without intermediate copies and stages like Take stop early:
+//
+//	evens := stream.Filter(tree.SeqValues(), func(value any) bool { return value.(int)%2 == 0 })
+//	squares := stream.Map(evens, func(value any) int { return value.(int) * value.(int) })
+//	list := stream.IntoList(stream.Take(squares, 10), arraylist.New[int]())
+//
+// Pipelines start from the Seq, SeqKeys and SeqValues methods of the containers, or from any of their iterators
+// through FromIndex and FromKey, and end in a terminal operation (Reduce, GroupBy) or a collector into a container.
+package stream
+
+import (
+	"iter"
+
+	"github.com/Arafatk/Dataviz/containers"
+)
+
+// Pair holds two related values, like the key and value of a map's element or the elements zipped by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// FromIndex returns a sequence of the values of the iterator, from the first to the last element.
+// The iterator is reset with Begin every time the sequence is ranged over.
+func FromIndex(it containers.IteratorWithIndex) iter.Seq[any] {
+	return func(yield func(any) bool) {
+		for it.Begin(); it.Next(); {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// FromKey returns a sequence of the key-value pairs of the iterator, from the first to the last element.
+// The iterator is reset with Begin every time the sequence is ranged over.
+func FromKey(it containers.IteratorWithKey) iter.Seq[Pair[any, any]] {
+	return func(yield func(Pair[any, any]) bool) {
+		for it.Begin(); it.Next(); {
+			if !yield(Pair[any, any]{it.Key(), it.Value()}) {
+				return
+			}
+		}
+	}
+}
+
+// Pairs turns a sequence of pairs, like the Seq of a map, into a sequence of Pair values.
+func Pairs[A, B any](seq iter.Seq2[A, B]) iter.Seq[Pair[A, B]] {
+	return func(yield func(Pair[A, B]) bool) {
+		for first, second := range seq {
+			if !yield(Pair[A, B]{first, second}) {
+				return
+			}
+		}
+	}
+}
+
+// Unpair turns a sequence of Pair values back into a sequence of pairs, e.g. to collect it with IntoMap.
+func Unpair[A, B any](seq iter.Seq[Pair[A, B]]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		for pair := range seq {
+			if !yield(pair.First, pair.Second) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns a sequence of the results of f applied to every element of the sequence.
+func Map[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for value := range seq {
+			if !yield(f(value)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the elements of the sequence satisfying the predicate.
+func Filter[T any](seq iter.Seq[T], predicate func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for value := range seq {
+			if predicate(value) && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence of the first n elements of the sequence.
+// The sequence is not ranged over any further once n elements were taken.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for value := range seq {
+			if !yield(value) {
+				return
+			}
+			if taken++; taken == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence of the elements of the sequence following its first n elements.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for value := range seq {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Zip returns a sequence of pairs of the elements of both sequences at the same position.
+// The sequence ends with the shorter one of both sequences.
+func Zip[A, B any](first iter.Seq[A], second iter.Seq[B]) iter.Seq[Pair[A, B]] {
+	return func(yield func(Pair[A, B]) bool) {
+		next, stop := iter.Pull(second)
+		defer stop()
+		for a := range first {
+			b, ok := next()
+			if !ok || !yield(Pair[A, B]{a, b}) {
+				return
+			}
+		}
+	}
+}
+
+// FlatMap returns the concatenation of the sequences returned by f for every element of the sequence.
+func FlatMap[T, U any](seq iter.Seq[T], f func(T) iter.Seq[U]) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for value := range seq {
+			for mapped := range f(value) {
+				if !yield(mapped) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence of consecutive chunks of size elements of the sequence, the last one possibly shorter.
+// Every chunk is a new slice. Chunk panics if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("stream: chunk size must be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, size)
+		for value := range seq {
+			chunk = append(chunk, value)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, size)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Distinct returns a sequence of the elements of the sequence, leaving out the ones equal to an earlier element.
+// The elements seen so far are kept in a set.
+func Distinct[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := map[T]struct{}{}
+		for value := range seq {
+			if _, found := seen[value]; found {
+				continue
+			}
+			seen[value] = struct{}{}
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce ranges over the sequence and returns the result of combining the initial value with every element in turn.
+func Reduce[T, A any](seq iter.Seq[T], initial A, f func(A, T) A) A {
+	accumulator := initial
+	for value := range seq {
+		accumulator = f(accumulator, value)
+	}
+	return accumulator
+}
+
+// GroupBy ranges over the sequence and returns its elements grouped by the key computed by f, in their order within every group.
+func GroupBy[T any, K comparable](seq iter.Seq[T], f func(T) K) map[K][]T {
+	groups := map[K][]T{}
+	for value := range seq {
+		key := f(value)
+		groups[key] = append(groups[key], value)
+	}
+	return groups
+}
+
+// Adder is implemented by containers collecting values with Add, like the lists.
+type Adder[T any] interface {
+	Add(values ...T)
+}
+
+// Pusher is implemented by containers collecting values with Push, like the stacks.
+type Pusher[T any] interface {
+	Push(value T)
+}
+
+// BulkPusher is implemented by containers collecting values with a variadic Push, like the heaps.
+type BulkPusher[T any] interface {
+	Push(values ...T)
+}
+
+// Putter is implemented by containers collecting key-value pairs with Put, like the maps and trees.
+type Putter[K, V any] interface {
+	Put(key K, value V)
+}
+
+// IntoList adds all elements of the sequence to the container in order and returns the container.
+func IntoList[T any, C Adder[T]](seq iter.Seq[T], container C) C {
+	for value := range seq {
+		container.Add(value)
+	}
+	return container
+}
+
+// IntoStack pushes all elements of the sequence onto the container in order and returns the container.
+func IntoStack[T any, C Pusher[T]](seq iter.Seq[T], container C) C {
+	for value := range seq {
+		container.Push(value)
+	}
+	return container
+}
+
+// IntoHeap pushes all elements of the sequence into the container and returns the container.
+func IntoHeap[T any, C BulkPusher[T]](seq iter.Seq[T], container C) C {
+	for value := range seq {
+		container.Push(value)
+	}
+	return container
+}
+
+// IntoMap puts all pairs of the sequence into the container, later keys replacing earlier ones, and returns the container.
+func IntoMap[K, V any, C Putter[K, V]](seq iter.Seq2[K, V], container C) C {
+	for key, value := range seq {
+		container.Put(key, value)
+	}
+	return container
+}
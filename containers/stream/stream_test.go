@@ -0,0 +1,136 @@
+package stream
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/maps/treemap"
+	"github.com/riadafridishibly/DataViz/stacks/arraystack"
+	"github.com/riadafridishibly/DataViz/trees/binaryheap"
+	"github.com/riadafridishibly/DataViz/trees/redblacktree"
+)
+
+// counted returns a sequence of the ints from 0 to n-1 and a pointer to the number of elements yielded so far.
+func counted(n int) (iter.Seq[int], *int) {
+	count := 0
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			count++
+			if !yield(i) {
+				return
+			}
+		}
+	}, &count
+}
+
+func TestStreamIsLazy(t *testing.T) {
+	seq, count := counted(1000000)
+	pipeline := Take(Filter(Map(seq, func(i int) int { return i * 3 }), func(i int) bool { return i%2 == 0 }), 3)
+	if *count != 0 {
+		t.Errorf("Got %v expected nothing to be evaluated before ranging", *count)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(pipeline)), "[0 6 12]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if *count != 5 {
+		t.Errorf("Got %v expected %v elements to be evaluated", *count, 5)
+	}
+}
+
+func TestStreamStages(t *testing.T) {
+	seq, _ := counted(7)
+
+	// stage, expected
+	tests := [][]any{
+		{slices.Collect(Skip(seq, 4)), "[4 5 6]"},
+		{slices.Collect(Skip(seq, 10)), "[]"},
+		{slices.Collect(Take(seq, 0)), "[]"},
+		{slices.Collect(Take(seq, 10)), "[0 1 2 3 4 5 6]"},
+		{slices.Collect(Chunk(seq, 3)), "[[0 1 2] [3 4 5] [6]]"},
+		{slices.Collect(Take(Chunk(seq, 2), 2)), "[[0 1] [2 3]]"},
+		{slices.Collect(Distinct(Map(seq, func(i int) int { return i % 3 }))), "[0 1 2]"},
+		{slices.Collect(FlatMap(Take(seq, 3), func(i int) iter.Seq[int] { return Take(seq, i) })), "[0 0 1]"},
+		{slices.Collect(Zip(seq, Map(Skip(seq, 5), func(i int) string { return fmt.Sprint(i) }))), "[{0 5} {1 6}]"},
+		{slices.Collect(Take(Zip(seq, seq), 1)), "[{0 0}]"},
+		{Reduce(seq, "", func(s string, i int) string { return s + fmt.Sprint(i) }), "0123456"},
+		{GroupBy(seq, func(i int) bool { return i%2 == 0 }), "map[false:[1 3 5] true:[0 2 4 6]]"},
+	}
+	for i, test := range tests {
+		if actualValue := fmt.Sprint(test[0]); actualValue != test[1] {
+			t.Errorf("Stage %v: got %v expected %v", i, actualValue, test[1])
+		}
+	}
+}
+
+func TestStreamEarlyStop(t *testing.T) {
+	seq, count := counted(100)
+	for chunk := range Chunk(Distinct(seq), 4) {
+		if chunk[0] == 4 {
+			break
+		}
+	}
+	if *count != 8 {
+		t.Errorf("Got %v expected %v elements to be evaluated", *count, 8)
+	}
+	*count = 0
+	for pair := range Zip(seq, Skip(seq, 50)) {
+		if pair.First == 2 {
+			break
+		}
+	}
+	if *count != 3+53 {
+		t.Errorf("Got %v expected %v elements to be evaluated", *count, 3+53)
+	}
+}
+
+func TestStreamContainers(t *testing.T) {
+	tree := redblacktree.NewWithIntComparator()
+	for i := 0; i < 20; i++ {
+		tree.Put(i, fmt.Sprint(i))
+	}
+
+	// keys of the tree into a stack
+	odd := Filter(tree.SeqKeys(), func(key any) bool { return key.(int)%2 == 1 })
+	stack := IntoStack(Take(odd, 3), arraystack.New())
+	if actualValue, expectedValue := fmt.Sprint(stack.Values()), "[5 3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// values of the tree into a heap
+	heap := IntoHeap(Skip(tree.SeqValues(), 17), binaryheap.NewWithStringComparator())
+	if actualValue, _ := heap.Peek(); actualValue != "17" {
+		t.Errorf("Got %v expected %v", actualValue, "17")
+	}
+
+	// entries of an iterator into a map with swapped keys and values
+	it := tree.Iterator()
+	swapped := Map(Take(FromKey(&it), 3), func(pair Pair[any, any]) Pair[any, any] { return Pair[any, any]{pair.Second, pair.First} })
+	m := IntoMap(Unpair(swapped), treemap.NewWithStringComparator())
+	if actualValue, expectedValue := m.String(), "TreeMap\nmap[0:0 1:1 2:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(Take(Pairs(m.Seq()), 1))), "[{0 0}]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// values of an iterator with an index
+	stackIt := stack.Iterator()
+	sum := Reduce(FromIndex(&stackIt), 0, func(sum int, value any) int { return sum + value.(int) })
+	if sum != 9 {
+		t.Errorf("Got %v expected %v", sum, 9)
+	}
+}
+
+type adder []int
+
+func (a *adder) Add(values ...int) { *a = append(*a, values...) }
+
+func TestStreamIntoList(t *testing.T) {
+	seq, _ := counted(5)
+	list := IntoList(Map(seq, func(i int) int { return i * i }), &adder{})
+	if actualValue, expectedValue := fmt.Sprint(*list), "[0 1 4 9 16]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
@@ -1,6 +1,6 @@
 module github.com/riadafridishibly/DataViz
 
-go 1.18
+go 1.23
 
 replace github.com/Arafatk/Dataviz v0.0.0-20190312232637-a92bdc2b62a5 => github.com/riadafridishibly/DataViz v0.0.0-20190312232637-a92bdc2b62a5
 
@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Arafatk/Dataviz/utils"
 	"github.com/riadafridishibly/DataViz/lists"
+	"github.com/riadafridishibly/DataViz/utils"
 )
 
 func assertList[T comparable]() {
@@ -21,6 +21,8 @@ func assertList[T comparable]() {
 type List[T comparable] struct {
 	elements []T
 	size     int
+	modCount int  // number of modifications, used by iterators to detect concurrent modification
+	shared   *int // number of lists sharing the elements since a snapshot, nil if the elements are not shared
 }
 
 const (
@@ -35,6 +37,7 @@ func New[T comparable]() *List[T] {
 
 // Add appends a value at the end of the list
 func (list *List[T]) Add(values ...T) {
+	list.modified()
 	list.growBy(len(values))
 	for _, value := range values {
 		list.elements[list.size] = value
@@ -58,6 +61,7 @@ func (list *List[T]) Remove(index int) (value T) {
 	if !list.withinRange(index) {
 		return
 	}
+	list.modified()
 
 	// list.elements[index] = nil                                    // cleanup reference
 	value = list.elements[index]
@@ -77,7 +81,7 @@ func (list *List[T]) Contains(values ...T) bool {
 
 	for _, searchValue := range values {
 		found := false
-		for _, element := range list.elements {
+		for _, element := range list.elements[:list.size] {
 			if element == searchValue {
 				found = true
 				break
@@ -102,7 +106,7 @@ func (list *List[T]) IndexOf(value T) int {
 	if list.size == 0 {
 		return -1
 	}
-	for index, element := range list.elements {
+	for index, element := range list.elements[:list.size] {
 		if element == value {
 			return index
 		}
@@ -124,19 +128,47 @@ func (list *List[T]) Size() int {
 func (list *List[T]) Clear() {
 	list.size = 0
 	list.elements = []T{}
+	list.modCount++
+	list.release()
 }
 
-// Sort sorts values (in-place) using.
+// Snapshot returns a copy of the list in O(1) time, meant as a frozen view for long-running iterations or visualizations.
+// The list and the snapshot share their elements until either of them is modified, which then first copies
+// the elements in O(n) time, so that modifying one never affects the other or the iterators over the other.
+func (list *List[T]) Snapshot() *List[T] {
+	if list.shared == nil {
+		list.shared = new(int)
+		*list.shared = 1
+	}
+	*list.shared++
+	return &List[T]{elements: list.elements, size: list.size, shared: list.shared}
+}
+
+// Sort sorts values (in-place) using a stable sort, so equal values keep their order.
 func (list *List[T]) Sort(comparator utils.Comparator) {
 	if len(list.elements) < 2 {
 		return
 	}
-	utils.Sort(list.elements[:list.size], comparator)
+	list.modified()
+	utils.SortStable(list.elements[:list.size], comparator)
+}
+
+// SearchFirst searches the list sorted with respect to the given comparator for the first element equal to the value using binary search.
+// Returns its index and true if found, otherwise the index at which the value would be inserted to keep the list sorted and false.
+func (list *List[T]) SearchFirst(value T, comparator utils.Comparator) (index int, found bool) {
+	return utils.SearchFirst(list.elements[:list.size], value, comparator)
+}
+
+// SearchLast searches the list sorted with respect to the given comparator for the last element equal to the value using binary search.
+// Returns its index and true if found, otherwise the index at which the value would be inserted to keep the list sorted and false.
+func (list *List[T]) SearchLast(value T, comparator utils.Comparator) (index int, found bool) {
+	return utils.SearchLast(list.elements[:list.size], value, comparator)
 }
 
 // Swap swaps the two values at the specified positions.
 func (list *List[T]) Swap(i, j int) {
 	if list.withinRange(i) && list.withinRange(j) {
+		list.modified()
 		list.elements[i], list.elements[j] = list.elements[j], list.elements[i]
 	}
 }
@@ -153,6 +185,7 @@ func (list *List[T]) Insert(index int, values ...T) {
 		}
 		return
 	}
+	list.modified()
 
 	l := len(values)
 	list.growBy(l)
@@ -183,6 +216,26 @@ func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
 
+// modified must be called before the elements of the list are changed. It invalidates the running iterators and,
+// if the elements are shared with snapshots, replaces them with a copy.
+func (list *List[T]) modified() {
+	list.modCount++
+	if list.release() {
+		list.resize(cap(list.elements))
+	}
+}
+
+// release stops sharing the elements of the list and returns true if other lists still use them.
+func (list *List[T]) release() bool {
+	if list.shared == nil {
+		return false
+	}
+	*list.shared--
+	used := *list.shared > 0
+	list.shared = nil
+	return used
+}
+
 func (list *List[T]) resize(cap int) {
 	newElements := make([]T, cap, cap)
 	copy(newElements, list.elements)
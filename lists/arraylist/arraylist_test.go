@@ -1,11 +1,17 @@
 package arraylist
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"iter"
+	"slices"
 	"strings"
 	"testing"
 
-	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/utils"
 )
 
 func TestListAdd(t *testing.T) {
@@ -115,6 +121,53 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListSortStable(t *testing.T) {
+	type pair struct {
+		key   int
+		order int
+	}
+	list := New[pair]()
+	for i := 0; i < 100; i++ {
+		list.Add(pair{key: (i * 7) % 5, order: i})
+	}
+	list.Sort(func(a, b any) int { return utils.IntComparator(a.(pair).key, b.(pair).key) })
+	for i := 1; i < list.Size(); i++ {
+		a, _ := list.Get(i - 1)
+		b, _ := list.Get(i)
+		if a.key > b.key || (a.key == b.key && a.order > b.order) {
+			t.Errorf("Not sorted stably! %v before %v", a, b)
+		}
+	}
+}
+
+func TestListSearch(t *testing.T) {
+	list := New[int]()
+	if index, found := list.SearchFirst(1, utils.IntComparator); index != 0 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 0, false)
+	}
+	list.Add(1, 3, 3, 3, 5)
+	list.Remove(4) // the removed 5 stays in the backing array beyond the size
+	tests := []struct {
+		value       int
+		first, last int
+		found       bool
+	}{
+		{0, 0, 0, false},
+		{1, 0, 0, true},
+		{3, 1, 3, true},
+		{4, 4, 4, false},
+		{5, 4, 4, false},
+	}
+	for _, test := range tests {
+		if index, found := list.SearchFirst(test.value, utils.IntComparator); index != test.first || found != test.found {
+			t.Errorf("Got %v %v expected %v %v for %v", index, found, test.first, test.found, test.value)
+		}
+		if index, found := list.SearchLast(test.value, utils.IntComparator); index != test.last || found != test.found {
+			t.Errorf("Got %v %v expected %v %v for %v", index, found, test.last, test.found, test.value)
+		}
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -147,6 +200,20 @@ func TestListContains(t *testing.T) {
 	if actualValue := list.Contains("a", "b", "c"); actualValue != false {
 		t.Errorf("Got %v expected %v", actualValue, false)
 	}
+
+	// the backing array beyond the size holds zero values, which are not elements
+	ints := New[int]()
+	ints.Add(1, 2, 3)
+	ints.Remove(2)
+	if actualValue := ints.Contains(0); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := ints.Contains(3); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := ints.IndexOf(0); actualValue != -1 {
+		t.Errorf("Got %v expected %v", actualValue, -1)
+	}
 }
 
 func TestListValues(t *testing.T) {
@@ -452,6 +519,80 @@ func TestListIteratorLast(t *testing.T) {
 	}
 }
 
+func TestListIteratorConcurrentModification(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	modifications := []func(){
+		func() { list.Add("d") },
+		func() { list.Remove(0) },
+		func() { list.Insert(1, "e") },
+		func() { list.Swap(0, 1) },
+		func() { list.Sort(utils.StringComparator) },
+		func() { list.Clear() },
+	}
+	for i, modify := range modifications {
+		list.Add("a", "b")
+		it := list.Iterator()
+		if !it.Next() || it.Err() != nil {
+			t.Errorf("Got %v expected a first element", it.Err())
+		}
+		modify()
+		if it.Next() || it.Prev() {
+			t.Errorf("Modification %v: expected the iteration to stop", i)
+		}
+		if actualValue := it.Err(); actualValue != ErrConcurrentModification {
+			t.Errorf("Modification %v: got %v expected %v", i, actualValue, ErrConcurrentModification)
+		}
+	}
+
+	// a reset resumes the iteration
+	list.Add("y", "z")
+	it := list.Iterator()
+	list.Remove(0)
+	if !it.First() || it.Value() != "z" || it.Err() != nil {
+		t.Errorf("Got %v, %v expected %v, nil", it.Value(), it.Err(), "z")
+	}
+}
+
+func TestListSnapshot(t *testing.T) {
+	list := New[int]()
+	for i := 0; i < 10; i++ {
+		list.Add(i)
+	}
+	snapshot := list.Snapshot()
+
+	// the list can be modified while iterating over the snapshot
+	it := snapshot.Iterator()
+	count := 0
+	for it.Next() {
+		if it.Value() != count {
+			t.Errorf("Got %v expected %v", it.Value(), count)
+		}
+		list.Remove(0)
+		list.Add(count + 100)
+		count++
+	}
+	if it.Err() != nil || count != 10 {
+		t.Errorf("Got %v, %v expected nil, %v", it.Err(), count, 10)
+	}
+	if actualValue, expectedValue := fmt.Sprint(snapshot.Values()), "[0 1 2 3 4 5 6 7 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(list.Values()), "[100 101 102 103 104 105 106 107 108 109]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// appending to a snapshot does not write into the spare capacity shared with the list
+	list = New[int]()
+	list.Add(1, 2)
+	snapshot = list.Snapshot()
+	snapshot.Add(3)
+	list.Add(4)
+	if actualValue, expectedValue := fmt.Sprint(snapshot.Values(), list.Values()), "[1 2 3] [1 2 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestListSerialization(t *testing.T) {
 	list := New[string]()
 	list.Add("a", "b", "c")
@@ -479,6 +620,139 @@ func TestListSerialization(t *testing.T) {
 	assert()
 }
 
+func TestListBinary(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	data, err := list.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := New[string]()
+	loaded.Add("x")
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := New[int]().UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for elements of another type")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(list); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := New[string]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListWriteReadJSON(t *testing.T) {
+	list := New[int]()
+	list.Add(3, 1, 2)
+	var buffer bytes.Buffer
+	if err := list.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := buffer.String(), "[3,1,2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := New[int]()
+	loaded.Add(0)
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Values()), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.ReadJSON(strings.NewReader(`["a"]`)); err == nil {
+		t.Errorf("Expected error for elements of another type")
+	}
+}
+
+func TestListMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		List *List[int]
+	}
+	list := New[int]()
+	list.Add(3, 1, 2)
+	data, err := json.Marshal(document{Name: "numbers", List: list})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"numbers","List":[3,1,2]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var decoded document
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.List.Values()), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSeq(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b")
+	list.Add("c")
+	actual := []string{}
+	for index, value := range list.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:a 1:b 2:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(list.SeqValues())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for index, value := range list.Backward() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+		if index == 1 {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:c 1:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSeqConcurrentModification(t *testing.T) {
+	list := New[int]()
+	list.Add(1, 2, 3)
+	for _, sequence := range []func() iter.Seq2[int, int]{list.Seq, list.Backward} {
+		func() {
+			defer func() {
+				if actualValue := recover(); actualValue != ErrConcurrentModification {
+					t.Errorf("Got %v expected %v", actualValue, ErrConcurrentModification)
+				}
+			}()
+			for _, value := range sequence() {
+				list.Add(value)
+			}
+		}()
+	}
+}
+
+func FuzzList(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckList(New[int](), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func benchmarkGet[T comparable](b *testing.B, list *List[T], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -1,6 +1,6 @@
 package arraylist
 
-import "github.com/Arafatk/Dataviz/containers"
+import "github.com/riadafridishibly/DataViz/containers"
 
 func assertions[T comparable]() {
 	var _ containers.EnumerableWithIndex[T] = (*List[T])(nil)
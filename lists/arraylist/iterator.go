@@ -1,22 +1,35 @@
 package arraylist
 
-import "github.com/Arafatk/Dataviz/containers"
+import (
+	"errors"
+
+	"github.com/riadafridishibly/DataViz/containers"
+)
 
 func assertReverseIteratorWithIndex[T comparable]() {
 	var _ containers.ReverseIteratorWithIndex[T] = (*Iterator[T])(nil)
 }
 
+// ErrConcurrentModification is reported by an iterator whose list was modified since the iteration started.
+var ErrConcurrentModification = errors.New("arraylist: list modified during iteration")
+
 // Iterator holding the iterator's state
 type Iterator[T comparable] struct {
-	list  *List[T]
-	index int
+	list     *List[T]
+	index    int
+	modCount int // modification count of the list the iterator's index is valid for
+	err      error
 }
 
 func F() {}
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
+//
+// The iterator is fail-fast: once the list is modified other than through a reset of the iterator by Begin, End,
+// First or Last, Next and Prev return false and Err reports ErrConcurrentModification.
+// Iterate over a Snapshot of the list to modify the list meanwhile.
 func (list *List[T]) Iterator() Iterator[T] {
-	return Iterator[T]{list: list, index: -1}
+	return Iterator[T]{list: list, index: -1, modCount: list.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -24,6 +37,9 @@ func (list *List[T]) Iterator() Iterator[T] {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
+	if iterator.modified() {
+		return false
+	}
 	if iterator.index < iterator.list.size {
 		iterator.index++
 	}
@@ -34,6 +50,9 @@ func (iterator *Iterator[T]) Next() bool {
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
+	if iterator.modified() {
+		return false
+	}
 	if iterator.index >= 0 {
 		iterator.index--
 	}
@@ -56,12 +75,14 @@ func (iterator *Iterator[T]) Index() int {
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
 	iterator.index = -1
+	iterator.reset()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
 	iterator.index = iterator.list.size
+	iterator.reset()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -79,3 +100,21 @@ func (iterator *Iterator[T]) Last() bool {
 	iterator.End()
 	return iterator.Prev()
 }
+
+// Err returns ErrConcurrentModification if the iteration was stopped because the list was modified, otherwise nil.
+func (iterator *Iterator[T]) Err() error {
+	return iterator.err
+}
+
+// modified records and returns whether the list was modified since the iterator was created or reset.
+func (iterator *Iterator[T]) modified() bool {
+	if iterator.err == nil && iterator.modCount != iterator.list.modCount {
+		iterator.err = ErrConcurrentModification
+	}
+	return iterator.err != nil
+}
+
+func (iterator *Iterator[T]) reset() {
+	iterator.modCount = iterator.list.modCount
+	iterator.err = nil
+}
@@ -0,0 +1,44 @@
+package arraylist
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the indices and values of the list in order, for use with range.
+// Panics with ErrConcurrentModification if the list is modified during the iteration.
+func (list *List[T]) Seq() iter.Seq2[int, T] {
+	return func(yield func(index int, value T) bool) {
+		it := list.Iterator()
+		for it.Next() {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// SeqValues returns a sequence of the values of the list in order, for use with range.
+func (list *List[T]) SeqValues() iter.Seq[T] {
+	return seq.Values(list.Seq())
+}
+
+// Backward returns a sequence of the indices and values of the list in reverse order, for use with range.
+// Panics with ErrConcurrentModification if the list is modified during the iteration.
+func (list *List[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(index int, value T) bool) {
+		it := list.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
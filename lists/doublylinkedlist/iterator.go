@@ -1,7 +1,7 @@
 package doublylinkedlist
 
 import (
-	"github.com/Arafatk/Dataviz/containers"
+	"github.com/riadafridishibly/DataViz/containers"
 )
 
 func assertReverseIteratorWithIndex[T comparable]() {
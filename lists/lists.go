@@ -6,8 +6,8 @@
 package lists
 
 import (
-	"github.com/Arafatk/Dataviz/containers"
-	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/utils"
 )
 
 // List interface that all lists implement
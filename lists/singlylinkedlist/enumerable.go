@@ -1,6 +1,6 @@
 package singlylinkedlist
 
-import "github.com/Arafatk/Dataviz/containers"
+import "github.com/riadafridishibly/DataViz/containers"
 
 func assertEnumerableWithIndex[T comparable]() {
 	var _ containers.EnumerableWithIndex[T] = (*List[T])(nil)
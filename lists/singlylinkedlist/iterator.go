@@ -1,6 +1,6 @@
 package singlylinkedlist
 
-import "github.com/Arafatk/Dataviz/containers"
+import "github.com/riadafridishibly/DataViz/containers"
 
 func assertIteratorWithIndex[T comparable]() {
 	var _ containers.IteratorWithIndex[T] = (*Iterator[T])(nil)
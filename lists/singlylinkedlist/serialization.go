@@ -1,14 +1,24 @@
 package singlylinkedlist
 
 import (
+	"encoding/gob"
 	"encoding/json"
+	"io"
 
-	"github.com/Arafatk/Dataviz/containers"
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/binarycodec"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
 )
 
 func assertJSONSerializerDeserializer[T comparable]() {
 	var _ containers.JSONSerializer = (*List[T])(nil)
 	var _ containers.JSONDeserializer = (*List[T])(nil)
+	var _ containers.BinarySerializer = (*List[T])(nil)
+	var _ containers.BinaryDeserializer = (*List[T])(nil)
+	var _ gob.GobEncoder = (*List[T])(nil)
+	var _ gob.GobDecoder = (*List[T])(nil)
+	var _ json.Marshaler = (*List[T])(nil)
+	var _ json.Unmarshaler = (*List[T])(nil)
 }
 
 // ToJSON outputs the JSON representation of list's elements.
@@ -26,3 +36,57 @@ func (list *List[T]) FromJSON(data []byte) error {
 	}
 	return err
 }
+
+// MarshalJSON outputs the JSON representation of list's elements for encoding/json, same as ToJSON.
+func (list *List[T]) MarshalJSON() ([]byte, error) {
+	return list.ToJSON()
+}
+
+// UnmarshalJSON populates list's elements from the input JSON representation for encoding/json, same as FromJSON.
+func (list *List[T]) UnmarshalJSON(data []byte) error {
+	return list.FromJSON(data)
+}
+
+// WriteJSON writes the JSON representation of list's elements to the writer, same as ToJSON.
+// The elements are written one at a time while iterating over the list, see the jsonstream package.
+func (list *List[T]) WriteJSON(w io.Writer) error {
+	return jsonstream.WriteArray(w, list.SeqValues())
+}
+
+// ReadJSON populates list's elements from the JSON representation read from the reader, same as FromJSON.
+func (list *List[T]) ReadJSON(r io.Reader) error {
+	values, err := jsonstream.ReadArray[T](r)
+	if err != nil {
+		return err
+	}
+	list.Clear()
+	list.Add(values...)
+	return nil
+}
+
+// MarshalBinary outputs the compact binary representation of list's elements, see the binarycodec package.
+func (list *List[T]) MarshalBinary() ([]byte, error) {
+	return binarycodec.Marshal(list.SeqValues())
+}
+
+// UnmarshalBinary populates list's elements from the input binary representation.
+// Returns an error if an element is not of the list's element type.
+func (list *List[T]) UnmarshalBinary(data []byte) error {
+	values, err := binarycodec.Unmarshal[T](data)
+	if err != nil {
+		return err
+	}
+	list.Clear()
+	list.Add(values...)
+	return nil
+}
+
+// GobEncode outputs the binary representation of list's elements for encoding/gob, same as MarshalBinary.
+func (list *List[T]) GobEncode() ([]byte, error) {
+	return list.MarshalBinary()
+}
+
+// GobDecode populates list's elements from the binary representation for encoding/gob, same as UnmarshalBinary.
+func (list *List[T]) GobDecode(data []byte) error {
+	return list.UnmarshalBinary(data)
+}
@@ -9,8 +9,8 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/Arafatk/Dataviz/lists"
-	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/lists"
+	"github.com/riadafridishibly/DataViz/utils"
 )
 
 func assertList[T comparable]() {
@@ -181,20 +181,19 @@ func (list *List[T]) Clear() {
 	list.last = nil
 }
 
-// Sort sort values (in-place) using.
+// Sort sorts values (in-place) using a stable merge sort, so equal values keep their order.
+// The elements are relinked rather than their values copied, which takes O(n log n) time and no extra memory besides the recursion.
 func (list *List[T]) Sort(comparator utils.Comparator) {
-
 	if list.size < 2 {
 		return
 	}
-
-	values := list.Values()
-	utils.Sort(values, comparator)
-
-	list.Clear()
-
-	list.Add(values...)
-
+	list.first = mergeSort(list.first, list.size, comparator)
+	// restore the last element, which the merge sort does not maintain
+	last := list.first
+	for last.next != nil {
+		last = last.next
+	}
+	list.last = last
 }
 
 // Swap swaps values of two elements at the given indices.
@@ -272,3 +271,41 @@ func (list *List[T]) String() string {
 func (list *List[T]) withinRange(index int) bool {
 	return index >= 0 && index < list.size
 }
+
+// mergeSort sorts the chain of size elements starting at first by their values and returns its new first element.
+// Only the next links are maintained and the next link of the last element is cut.
+func mergeSort[T comparable](first *element[T], size int, comparator utils.Comparator) *element[T] {
+	if size == 1 {
+		first.next = nil
+		return first
+	}
+	middle := first
+	for i := 1; i < size/2; i++ {
+		middle = middle.next
+	}
+	second := middle.next
+	left := mergeSort(first, size/2, comparator)
+	right := mergeSort(second, size-size/2, comparator)
+	return merge(left, right, comparator)
+}
+
+// merge merges the sorted chains of elements starting at left and right and returns the first element of the merged chain.
+// On ties the element of the left chain comes first, which keeps the merge sort stable.
+func merge[T comparable](left *element[T], right *element[T], comparator utils.Comparator) *element[T] {
+	var head element[T]
+	tail := &head
+	for left != nil && right != nil {
+		if comparator(left.value, right.value) <= 0 {
+			tail.next, left = left, left.next
+		} else {
+			tail.next, right = right, right.next
+		}
+		tail = tail.next
+	}
+	if left != nil {
+		tail.next = left
+	} else {
+		tail.next = right
+	}
+	return head.next
+}
@@ -1,10 +1,16 @@
 package singlylinkedlist
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"slices"
 	"strings"
 	"testing"
 
-	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/utils"
 )
 
 func TestListAdd(t *testing.T) {
@@ -87,6 +93,29 @@ func TestListSort(t *testing.T) {
 	}
 }
 
+func TestListSortStable(t *testing.T) {
+	type pair struct {
+		key   int
+		order int
+	}
+	list := New[pair]()
+	for i := 0; i < 100; i++ {
+		list.Add(pair{key: (i * 7) % 5, order: i})
+	}
+	list.Sort(func(a, b any) int { return utils.IntComparator(a.(pair).key, b.(pair).key) })
+	for i := 1; i < list.Size(); i++ {
+		a, _ := list.Get(i - 1)
+		b, _ := list.Get(i)
+		if a.key > b.key || (a.key == b.key && a.order > b.order) {
+			t.Errorf("Not sorted stably! %v before %v", a, b)
+		}
+	}
+	list.Add(pair{key: -1, order: 100})
+	if actualValue, _ := list.Get(list.Size() - 1); actualValue.order != 100 {
+		t.Errorf("Got %v expected %v", actualValue.order, 100)
+	}
+}
+
 func TestListClear(t *testing.T) {
 	list := New[string]()
 	list.Add("e", "f", "g", "a", "b", "c", "d")
@@ -396,6 +425,122 @@ func TestListSerialization(t *testing.T) {
 	assert()
 }
 
+func TestListBinary(t *testing.T) {
+	list := New[string]()
+	list.Add("a", "b", "c")
+	data, err := list.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := New[string]()
+	loaded.Add("x")
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := New[int]().UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for elements of another type")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(list); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := New[string]()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListWriteReadJSON(t *testing.T) {
+	list := New[int]()
+	list.Add(3, 1, 2)
+	var buffer bytes.Buffer
+	if err := list.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := buffer.String(), "[3,1,2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := New[int]()
+	loaded.Add(0)
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Values()), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.ReadJSON(strings.NewReader(`["a"]`)); err == nil {
+		t.Errorf("Expected error for elements of another type")
+	}
+}
+
+func TestListMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		List *List[int]
+	}
+	list := New[int]()
+	list.Add(3, 1, 2)
+	data, err := json.Marshal(document{Name: "numbers", List: list})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"numbers","List":[3,1,2]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var decoded document
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.List.Values()), "[3 1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestListSeq(t *testing.T) {
+	list := New[string]()
+	list.Add("a")
+	list.Add("b")
+	list.Add("c")
+	actual := []string{}
+	for index, value := range list.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:a 1:b 2:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(list.SeqValues())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for index, value := range list.Backward() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+		if index == 1 {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:c 1:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzList(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckList(New[int](), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func benchmarkGet[T comparable](b *testing.B, list *List[T], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
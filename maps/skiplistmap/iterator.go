@@ -0,0 +1,69 @@
+package skiplistmap
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.IteratorWithKey = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	m     *Map
+	node  *node
+	begun bool
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator is weakly consistent, see the package documentation.
+func (m *Map) Iterator() Iterator {
+	return Iterator{m: m}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	switch {
+	case !iterator.begun:
+		iterator.node = iterator.m.first()
+		iterator.begun = true
+	case iterator.node != nil:
+		iterator.node = iterator.node.next0()
+	}
+	return iterator.node != nil
+}
+
+// Seek moves the iterator to the element with the smallest key larger than or equal to the given key
+// and returns true if there is such an element. Calling Next() afterwards continues the iteration in-order.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) Seek(key any) bool {
+	iterator.node = iterator.m.seek(key, true)
+	iterator.begun = true
+	return iterator.node != nil
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() any {
+	return iterator.node.getValue()
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() any {
+	return iterator.node.key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.node = nil
+	iterator.begun = false
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
@@ -0,0 +1,31 @@
+package skiplistmap
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the keys and values of the map in-order, for use with range.
+// Like iterators, the sequence is weakly consistent.
+func (m *Map) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		for it := m.Iterator(); it.Next(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the map in-order, for use with range.
+// Like iterators, the sequence is weakly consistent.
+func (m *Map) SeqKeys() iter.Seq[any] {
+	return seq.Keys(m.Seq())
+}
+
+// SeqValues returns a sequence of the values of the map in-order based on the key, for use with range.
+// Like iterators, the sequence is weakly consistent.
+func (m *Map) SeqValues() iter.Seq[any] {
+	return seq.Values(m.Seq())
+}
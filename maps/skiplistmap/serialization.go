@@ -0,0 +1,60 @@
+package skiplistmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+var _ containers.JSONSerializer = (*Map)(nil)
+var _ containers.JSONDeserializer = (*Map)(nil)
+var _ json.Marshaler = (*Map)(nil)
+var _ json.Unmarshaler = (*Map)(nil)
+
+// ToJSON outputs the JSON representation of the map's elements as a JSON object with the keys converted to strings.
+// Like iterators, the output is weakly consistent.
+func (m *Map) ToJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := jsonstream.WriteElements(&buffer, nil, m.Seq()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// FromJSON populates the map's elements from the input JSON representation.
+// The keys of a JSON object are restored as strings, the keys of an array of key-value pairs are decoded by keycodec.Typed,
+// and an error is returned if the comparator cannot compare the keys.
+// The map is cleared and refilled element by element, so concurrent operations may interleave with the refill.
+func (m *Map) FromJSON(data []byte) error {
+	keys, values, err := jsonstream.ReadElements(bytes.NewReader(data), keycodec.Typed)
+	if err != nil {
+		return err
+	}
+	if err := keycodec.CheckKeys(keys, m.Comparator); err != nil {
+		return err
+	}
+	m.Clear()
+	for i, key := range keys {
+		m.Put(key, values[i])
+	}
+	return nil
+}
+
+// MarshalJSON outputs the JSON representation of the map's elements for encoding/json, same as ToJSON.
+func (m *Map) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
+
+// UnmarshalJSON populates the map's elements from the input JSON representation for encoding/json, same as FromJSON.
+// The map keeps its comparator, so it has to be instantiated with one before decoding into it,
+// e.g. a *Map field of a struct has to be set before decoding the struct, as decoding into a map without a comparator fails.
+func (m *Map) UnmarshalJSON(data []byte) error {
+	if m.Comparator == nil || m.head == nil {
+		return errors.New("skiplistmap: map has no comparator")
+	}
+	return m.FromJSON(data)
+}
@@ -0,0 +1,491 @@
+// Package skiplistmap implements a concurrent map backed by a skip list.
+//
+// Elements are ordered by key in the map.
+//
+// Structure is thread safe. The skip list is a lazy skip list: lookups, iteration and the ordered queries
+// never lock, while Put and Remove lock only the few nodes around the key they modify, so readers and writers
+// of different keys proceed concurrently. Keys, Values, String and iterators walk the map while it may be
+// modified concurrently, so they are weakly consistent: they see every element present for the whole walk and
+// possibly some put or removed meanwhile. Size is maintained separately and may lag behind concurrent operations.
+//
+// Reference: https://en.wikipedia.org/wiki/Skip_list
+package skiplistmap
+
+import (
+	"fmt"
+	"math/bits"
+	"math/rand"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+
+	"github.com/Arafatk/Dataviz/maps"
+	"github.com/Arafatk/Dataviz/utils"
+)
+
+var _ maps.Map = (*Map)(nil)
+
+// maxLevel is the highest tower a node can have, enough for billions of elements as every level halves the nodes.
+const maxLevel = 32
+
+// Map holds the elements in a skip list
+type Map struct {
+	head       *node // sentinel with a tower of maxLevel, its key is never compared
+	size       int64
+	Comparator utils.Comparator
+}
+
+type node struct {
+	key   any
+	value unsafe.Pointer   // *any
+	next  []unsafe.Pointer // *node per level, nil is the end of the level
+	mu    sync.Mutex
+
+	// marked is set when the node is being removed, fullyLinked when it is linked on all levels of its tower.
+	// A node is in the map if it is fully linked and not marked.
+	marked      int32
+	fullyLinked int32
+}
+
+// NewWith instantiates a skip list map with the custom comparator.
+func NewWith(comparator utils.Comparator) *Map {
+	return &Map{head: newNode(nil, nil, maxLevel), Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates a skip list map with the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator() *Map {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a skip list map with the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator() *Map {
+	return NewWith(utils.StringComparator)
+}
+
+// Put inserts key-value pair into the map, replacing the value of an existing key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Put(key any, value any) {
+	height := randomHeight()
+	var preds, succs [maxLevel]*node
+	for {
+		if level := m.find(key, &preds, &succs); level != -1 {
+			found := succs[level]
+			for !found.isMarked() && !found.isFullyLinked() {
+				runtime.Gosched() // wait for the concurrent insertion of the key to complete
+			}
+			found.mu.Lock()
+			if !found.isMarked() {
+				found.setValue(value)
+				found.mu.Unlock()
+				return
+			}
+			// the key is being removed, yield to the remover and retry once it is unlinked
+			found.mu.Unlock()
+			runtime.Gosched()
+			continue
+		}
+
+		locked, valid := -1, true
+		for level := 0; valid && level < height; level++ {
+			pred, succ := preds[level], succs[level]
+			if level == 0 || pred != preds[level-1] {
+				pred.mu.Lock()
+			}
+			locked = level
+			valid = !pred.isMarked() && (succ == nil || !succ.isMarked()) && pred.successor(level) == succ
+		}
+		if valid {
+			n := newNode(key, value, height)
+			for level := 0; level < height; level++ {
+				n.next[level] = unsafe.Pointer(succs[level])
+			}
+			for level := 0; level < height; level++ {
+				atomic.StorePointer(&preds[level].next[level], unsafe.Pointer(n))
+			}
+			atomic.StoreInt32(&n.fullyLinked, 1)
+			atomic.AddInt64(&m.size, 1)
+		}
+		unlock(&preds, locked)
+		if valid {
+			return
+		}
+	}
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Get(key any) (value any, found bool) {
+	var preds, succs [maxLevel]*node
+	if level := m.find(key, &preds, &succs); level != -1 && succs[level].live() {
+		return succs[level].getValue(), true
+	}
+	return nil, false
+}
+
+// Remove removes the element from the map by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Remove(key any) {
+	var preds, succs [maxLevel]*node
+	var victim *node
+	for {
+		level := m.find(key, &preds, &succs)
+		if victim == nil {
+			if level == -1 {
+				return
+			}
+			candidate := succs[level]
+			// a node not yet fully linked is still being inserted, so the key is not in the map yet
+			if !candidate.isFullyLinked() || len(candidate.next)-1 != level || candidate.isMarked() {
+				return
+			}
+			candidate.mu.Lock()
+			if candidate.isMarked() {
+				candidate.mu.Unlock()
+				return
+			}
+			atomic.StoreInt32(&candidate.marked, 1)
+			victim = candidate
+		}
+
+		height := len(victim.next)
+		locked, valid := -1, true
+		for level := 0; valid && level < height; level++ {
+			pred := preds[level]
+			if level == 0 || pred != preds[level-1] {
+				pred.mu.Lock()
+			}
+			locked = level
+			valid = !pred.isMarked() && pred.successor(level) == victim
+		}
+		if valid {
+			for level := height - 1; level >= 0; level-- {
+				atomic.StorePointer(&preds[level].next[level], atomic.LoadPointer(&victim.next[level]))
+			}
+			victim.mu.Unlock()
+			atomic.AddInt64(&m.size, -1)
+		}
+		unlock(&preds, locked)
+		if valid {
+			return
+		}
+	}
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	return m.first() == nil
+}
+
+// Size returns number of elements in the map.
+func (m *Map) Size() int {
+	if size := atomic.LoadInt64(&m.size); size > 0 {
+		return int(size)
+	}
+	return 0
+}
+
+// Keys returns all keys in-order
+func (m *Map) Keys() []any {
+	keys := []any{}
+	for n := m.first(); n != nil; n = n.next0() {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (m *Map) Values() []any {
+	values := []any{}
+	for n := m.first(); n != nil; n = n.next0() {
+		values = append(values, n.getValue())
+	}
+	return values
+}
+
+// Clear removes all elements from the map.
+// Elements put concurrently may or may not be removed.
+func (m *Map) Clear() {
+	for n := m.first(); n != nil; n = n.next0() {
+		m.Remove(n.key)
+	}
+}
+
+// Min returns the minimum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map) Min() (key any, value any) {
+	if n := m.first(); n != nil {
+		return n.key, n.getValue()
+	}
+	return nil, nil
+}
+
+// Max returns the maximum key and its value from the map.
+// Returns nil, nil if map is empty.
+func (m *Map) Max() (key any, value any) {
+	if n := m.last(nil, false); n != nil {
+		return n.key, n.getValue()
+	}
+	return nil, nil
+}
+
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Floor(key any) (foundKey any, foundValue any) {
+	if n := m.last(key, true); n != nil {
+		return n.key, n.getValue()
+	}
+	return nil, nil
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Ceiling(key any) (foundKey any, foundValue any) {
+	if n := m.seek(key, true); n != nil {
+		return n.key, n.getValue()
+	}
+	return nil, nil
+}
+
+// Higher finds the key-value pair with the smallest key strictly larger than the input key.
+// In case that no such key is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Higher(key any) (foundKey any, foundValue any) {
+	if n := m.seek(key, false); n != nil {
+		return n.key, n.getValue()
+	}
+	return nil, nil
+}
+
+// Lower finds the key-value pair with the largest key strictly smaller than the input key.
+// In case that no such key is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Lower(key any) (foundKey any, foundValue any) {
+	if n := m.last(key, false); n != nil {
+		return n.key, n.getValue()
+	}
+	return nil, nil
+}
+
+// Range calls the given function once for each element with a key in [fromKey, toKey), in-order,
+// until the function returns false. A nil fromKey or toKey leaves the range unbounded on that side.
+// Like iterators, Range is weakly consistent.
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Range(fromKey any, toKey any, f func(key any, value any) bool) {
+	n := m.first()
+	if fromKey != nil {
+		n = m.seek(fromKey, true)
+	}
+	for ; n != nil; n = n.next0() {
+		if toKey != nil && m.Comparator(n.key, toKey) >= 0 {
+			return
+		}
+		if !f(n.key, n.getValue()) {
+			return
+		}
+	}
+}
+
+// String returns a string representation of container
+func (m *Map) String() string {
+	str := "SkipListMap\nmap["
+	it := m.Iterator()
+	for it.Next() {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value())
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// Visualizer makes a visual image demonstrating the skip list map data structure
+// using dot language and Graphviz. Every element is drawn as a tower of its levels with its key and value
+// at the bottom, the links of each level run from the head tower on the left to the nil terminator on the right.
+func (m *Map) Visualizer(fileName string) bool {
+	return utils.WriteDotStringToPng(fileName, m.dotString())
+}
+
+func (m *Map) dotString() string {
+	height := 1
+	for height < maxLevel && m.head.successor(height) != nil {
+		height++
+	}
+	tower := func(name string, levels int, label string) string {
+		fields := []string{}
+		for level := height - 1; level >= 0; level-- {
+			if level < levels {
+				fields = append(fields, fmt.Sprintf("<l%v>", level))
+			} else {
+				fields = append(fields, " ")
+			}
+		}
+		return fmt.Sprintf("%v [label=\"{%v|%v}\"];", name, strings.Join(fields, "|"), label)
+	}
+
+	dotString := "digraph graphname{rankdir=LR;splines=false;node [shape=record,style=filled,fillcolor=lightpink,color=royalblue];"
+	dotString += tower("head", height, "head")
+	nodes := []*node{}
+	for n := m.first(); n != nil; n = n.next0() {
+		name := fmt.Sprintf("n%v", len(nodes))
+		dotString += tower(name, len(n.next), escape(fmt.Sprintf("%v:%v", n.key, n.getValue())))
+		nodes = append(nodes, n)
+	}
+	dotString += tower("nil", height, "nil")
+	// each level links the towers tall enough to reach it, skipping the ones below it
+	for level := 0; level < height; level++ {
+		from := "head"
+		for i, n := range nodes {
+			if len(n.next) > level {
+				name := fmt.Sprintf("n%v", i)
+				dotString += fmt.Sprintf("%v:l%v -> %v:l%v;", from, level, name, level)
+				from = name
+			}
+		}
+		dotString += fmt.Sprintf("%v:l%v -> nil:l%v;", from, level, level)
+	}
+	return dotString + "}"
+}
+
+// escape escapes the characters with a special meaning in record labels.
+func escape(label string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "{", `\{`, "}", `\}`, "|", `\|`, "<", `\<`, ">", `\>`).Replace(label)
+}
+
+// find fills preds and succs with the nodes before and at or after the key on every level
+// and returns the highest level on which a node with the key was found, or -1 if it was not found.
+func (m *Map) find(key any, preds *[maxLevel]*node, succs *[maxLevel]*node) int {
+	found := -1
+	pred := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		curr := pred.successor(level)
+		compare := 1
+		for curr != nil {
+			if compare = m.Comparator(curr.key, key); compare >= 0 {
+				break
+			}
+			pred, curr = curr, curr.successor(level)
+		}
+		if found == -1 && curr != nil && compare == 0 {
+			found = level
+		}
+		preds[level], succs[level] = pred, curr
+	}
+	return found
+}
+
+// first returns the node with the smallest key in the map, or nil if map is empty.
+func (m *Map) first() *node {
+	return m.head.next0()
+}
+
+// seek returns the node with the smallest key larger than (or equal to, if inclusive) the key, or nil if there is none.
+func (m *Map) seek(key any, inclusive bool) *node {
+	pred := m.head
+	for level := maxLevel - 1; level >= 0; level-- {
+		for curr := pred.successor(level); curr != nil; curr = pred.successor(level) {
+			if compare := m.Comparator(curr.key, key); compare > 0 || (inclusive && compare == 0) {
+				break
+			}
+			pred = curr
+		}
+	}
+	return pred.next0()
+}
+
+// last returns the node with the largest key smaller than (or equal to, if inclusive) the key, or nil if there is none.
+// A nil key stands for a key larger than all keys.
+func (m *Map) last(key any, inclusive bool) *node {
+	for {
+		pred := m.head
+		for level := maxLevel - 1; level >= 0; level-- {
+			for curr := pred.successor(level); curr != nil; curr = pred.successor(level) {
+				if key != nil {
+					if compare := m.Comparator(curr.key, key); compare > 0 || (!inclusive && compare == 0) {
+						break
+					}
+				}
+				pred = curr
+			}
+		}
+		if pred == m.head {
+			return nil
+		}
+		if pred.live() {
+			return pred
+		}
+		// the node is being inserted or removed and there are no back links to its predecessor, retry once it is done
+		runtime.Gosched()
+	}
+}
+
+// unlock unlocks the distinct predecessors locked on levels up to and including the given level.
+func unlock(preds *[maxLevel]*node, locked int) {
+	for level := 0; level <= locked; level++ {
+		if level == 0 || preds[level] != preds[level-1] {
+			preds[level].mu.Unlock()
+		}
+	}
+}
+
+// randomHeight returns the height of a new tower, each level being half as likely as the one below it.
+func randomHeight() int {
+	height := bits.TrailingZeros32(rand.Uint32()) + 1
+	if height > maxLevel {
+		return maxLevel
+	}
+	return height
+}
+
+func newNode(key any, value any, height int) *node {
+	return &node{key: key, value: unsafe.Pointer(&value), next: make([]unsafe.Pointer, height)}
+}
+
+func (n *node) successor(level int) *node {
+	return (*node)(atomic.LoadPointer(&n.next[level]))
+}
+
+// next0 returns the following element of the map on the bottom level, skipping nodes not in the map.
+func (n *node) next0() *node {
+	next := n.successor(0)
+	for next != nil && !next.live() {
+		next = next.successor(0)
+	}
+	return next
+}
+
+func (n *node) getValue() any {
+	return *(*any)(atomic.LoadPointer(&n.value))
+}
+
+func (n *node) setValue(value any) {
+	atomic.StorePointer(&n.value, unsafe.Pointer(&value))
+}
+
+func (n *node) isMarked() bool {
+	return atomic.LoadInt32(&n.marked) == 1
+}
+
+func (n *node) isFullyLinked() bool {
+	return atomic.LoadInt32(&n.fullyLinked) == 1
+}
+
+func (n *node) live() bool {
+	return n.isFullyLinked() && !n.isMarked()
+}
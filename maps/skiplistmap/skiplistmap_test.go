@@ -0,0 +1,476 @@
+package skiplistmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/maps/treemap"
+	"github.com/riadafridishibly/DataViz/synchronized"
+)
+
+func TestMapPut(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(4, "d")
+	m.Put(1, "x")
+	m.Put(2, "b")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(m.Values()), "[a b c d e f g]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key,expectedValue,expectedFound
+	tests1 := [][]any{
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, nil, false},
+	}
+
+	for _, test := range tests1 {
+		// retrievals
+		actualValue, actualFound := m.Get(test[0])
+		if actualValue != test[1] || actualFound != test[2] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+	}
+	if err := m.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMapRemove(t *testing.T) {
+	m := NewWithIntComparator()
+	for i, value := range []string{"a", "b", "c", "d", "e", "f", "g"} {
+		m.Put(i+1, value)
+	}
+
+	m.Remove(5)
+	m.Remove(6)
+	m.Remove(7)
+	m.Remove(8)
+	m.Remove(5)
+
+	if actualValue, expectedValue := fmt.Sprint(m.Keys()), "[1 2 3 4]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, found := m.Get(5); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if err := m.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	m.Clear()
+	if actualValue := m.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := m.String(), "SkipListMap\nmap[]"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+	if err := m.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMapMinMaxFloorCeilingHigherLower(t *testing.T) {
+	m := NewWithIntComparator()
+	if key, value := m.Min(); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected nil:nil", key, value)
+	}
+	if key, value := m.Max(); key != nil || value != nil {
+		t.Errorf("Got %v:%v expected nil:nil", key, value)
+	}
+	m.Put(2, "b")
+	m.Put(4, "d")
+	m.Put(6, "f")
+	if key, value := m.Min(); key != 2 || value != "b" {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, 2, "b")
+	}
+	if key, value := m.Max(); key != 6 || value != "f" {
+		t.Errorf("Got %v:%v expected %v:%v", key, value, 6, "f")
+	}
+
+	// key, floor, ceiling, higher, lower
+	tests := [][]any{
+		{1, nil, 2, 2, nil},
+		{2, 2, 2, 4, nil},
+		{3, 2, 4, 4, 2},
+		{6, 6, 6, nil, 4},
+		{7, 6, nil, nil, 6},
+	}
+	for _, test := range tests {
+		if actualValue, _ := m.Floor(test[0]); actualValue != test[1] {
+			t.Errorf("Floor(%v): got %v expected %v", test[0], actualValue, test[1])
+		}
+		if actualValue, _ := m.Ceiling(test[0]); actualValue != test[2] {
+			t.Errorf("Ceiling(%v): got %v expected %v", test[0], actualValue, test[2])
+		}
+		if actualValue, _ := m.Higher(test[0]); actualValue != test[3] {
+			t.Errorf("Higher(%v): got %v expected %v", test[0], actualValue, test[3])
+		}
+		if actualValue, _ := m.Lower(test[0]); actualValue != test[4] {
+			t.Errorf("Lower(%v): got %v expected %v", test[0], actualValue, test[4])
+		}
+	}
+}
+
+func TestMapRange(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 0; i < 10; i++ {
+		m.Put(i*2, i)
+	}
+
+	// from, to, expected keys
+	tests := [][]any{
+		{nil, nil, "[0 2 4 6 8 10 12 14 16 18]"},
+		{3, 9, "[4 6 8]"},
+		{4, 8, "[4 6]"},
+		{nil, 5, "[0 2 4]"},
+		{15, nil, "[16 18]"},
+		{19, nil, "[]"},
+		{8, 8, "[]"},
+	}
+	for _, test := range tests {
+		keys := []any{}
+		m.Range(test[0], test[1], func(key any, value any) bool {
+			if value != key.(int)/2 {
+				t.Errorf("Got %v expected %v", value, key.(int)/2)
+			}
+			keys = append(keys, key)
+			return true
+		})
+		if actualValue := fmt.Sprint(keys); actualValue != test[2] {
+			t.Errorf("Range(%v, %v): got %v expected %v", test[0], test[1], actualValue, test[2])
+		}
+	}
+
+	count := 0
+	m.Range(nil, nil, func(key any, value any) bool {
+		count++
+		return count < 3
+	})
+	if count != 3 {
+		t.Errorf("Got %v expected %v", count, 3)
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if actualValue, expectedValue := it.Value(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Next() {
+		t.Errorf("Expected no element after the last one")
+	}
+	if !it.First() || it.Key() != "a" {
+		t.Errorf("Got %v expected %v", it.Key(), "a")
+	}
+	if !it.Seek("aa") || it.Key() != "b" || !it.Next() || it.Key() != "c" || it.Next() {
+		t.Errorf("Got %v expected %v", it.Key(), "c")
+	}
+	if it.Seek("d") {
+		t.Errorf("Got %v expected no element", it.Key())
+	}
+
+	// removing the current element does not end the iteration
+	for it.Begin(); it.Next(); {
+		m.Remove(it.Key())
+	}
+	if !m.Empty() {
+		t.Errorf("Got %v expected an empty map", m.Keys())
+	}
+	if it := m.Iterator(); it.Next() || it.First() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapDotString(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(1, "a")
+	m.Put(2, "b|c")
+	dotString := m.dotString()
+	for _, expected := range []string{
+		`head [label="{`,
+		`<l0>|1:a}`,
+		`2:b\|c}`,
+		"head:l0 -> n0:l0;n0:l0 -> n1:l0;n1:l0 -> nil:l0;",
+	} {
+		if !strings.Contains(dotString, expected) {
+			t.Errorf("Got %v expected it to contain %v", dotString, expected)
+		}
+	}
+}
+
+func TestMapValidate(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 0; i < 100; i++ {
+		m.Put(i, i)
+	}
+	if err := m.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	first := m.first()
+	first.key = 1
+	if err := m.Validate(); err == nil || !strings.Contains(err.Error(), "is not smaller than its successor") {
+		t.Errorf("Got %v expected an order error", err)
+	}
+	first.key = 0
+
+	m.size++
+	if err := m.Validate(); err == nil || !strings.Contains(err.Error(), "size") {
+		t.Errorf("Got %v expected a size error", err)
+	}
+	m.size--
+
+	first.marked = 1
+	if err := m.Validate(); err == nil || !strings.Contains(err.Error(), "linked but not in the map") {
+		t.Errorf("Got %v expected a removed node error", err)
+	}
+}
+
+func TestMapConcurrent(t *testing.T) {
+	const workers, iterations = 8, 500
+	m := NewWithIntComparator()
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(2)
+		// writers put and remove their own keys, interleaved with the other writers' keys
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				key := i*workers + worker
+				m.Put(key, worker)
+				if value, found := m.Get(key); !found || value != worker {
+					t.Errorf("Got %v, %v expected %v, true", value, found, worker)
+				}
+				if i%2 == 0 {
+					m.Remove(key)
+				}
+			}
+		}(worker)
+		// readers check that ordered queries and iterations stay ordered
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if key, _ := m.Floor(i); key != nil && key.(int) > i {
+					t.Errorf("Floor(%v): got %v", i, key)
+				}
+				if key, _ := m.Ceiling(i); key != nil && key.(int) < i {
+					t.Errorf("Ceiling(%v): got %v", i, key)
+				}
+				if i%50 == 0 {
+					previous := -1
+					m.Range(nil, nil, func(key any, value any) bool {
+						if key.(int) <= previous {
+							t.Errorf("Key %v after %v", key, previous)
+						}
+						previous = key.(int)
+						return true
+					})
+				}
+			}
+		}(worker)
+	}
+	wg.Wait()
+	if err := m.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if actualValue, expectedValue := m.Size(), workers*iterations/2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for i, key := range m.Keys() {
+		if expectedValue := (i/workers*2+1)*workers + i%workers; key != expectedValue {
+			t.Fatalf("Got %v expected %v", key, expectedValue)
+		}
+	}
+}
+
+func TestMapConcurrentSameKeys(t *testing.T) {
+	const workers, iterations, keys = 8, 2000, 16
+	m := NewWithIntComparator()
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				key := (i * (worker + 1)) % keys
+				switch i % 3 {
+				case 0:
+					m.Remove(key)
+				default:
+					m.Put(key, worker)
+				}
+				m.Get(key)
+				m.Lower(key)
+				m.Max()
+			}
+		}(worker)
+	}
+	wg.Wait()
+	if err := m.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if actualValue, expectedValue := m.Size(), len(m.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		Map  *Map
+	}
+	m := NewWithStringComparator()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	data, err := json.Marshal(document{Name: "letters", Map: m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Map":{"a":1,"b":2}}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	decoded := document{Map: NewWithStringComparator()}
+	decoded.Map.Put("c", 3)
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Map.Keys(), decoded.Map.Values()), "[a b] [1 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a map without a comparator")
+	}
+	if err := json.Unmarshal(data, &document{Map: NewWithIntComparator()}); err == nil {
+		t.Errorf("Expected error for string keys compared by the IntComparator")
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 5; i > 0; i-- {
+		m.Put(i, fmt.Sprint(i*10))
+	}
+	actual := []string{}
+	for key, value := range m.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[1:10 2:20 3:30 4:40 5:50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(m.SeqKeys())), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(m.SeqValues())), "[10 20 30 40 50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzMap(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckMap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// benchmarkMixed runs gets with one put or remove in every ten operations from all goroutines at once.
+func benchmarkMixed(b *testing.B, m interface {
+	Put(key any, value any)
+	Get(key any) (any, bool)
+	Remove(key any)
+}) {
+	size := 10000
+	for n := 0; n < size; n += 2 {
+		m.Put(n, struct{}{})
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			key := (i * 7919) % size
+			switch i % 10 {
+			case 0:
+				m.Put(key, struct{}{})
+			case 5:
+				m.Remove(key)
+			default:
+				m.Get(key)
+			}
+		}
+	})
+}
+
+func BenchmarkSkipListMapMixed(b *testing.B) {
+	benchmarkMixed(b, NewWithIntComparator())
+}
+
+func BenchmarkSynchronizedTreeMapMixed(b *testing.B) {
+	benchmarkMixed(b, synchronized.NewMap(treemap.NewWithIntComparator()))
+}
+
+func BenchmarkSkipListMapGet10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
+
+func BenchmarkSkipListMapPut10000(b *testing.B) {
+	size := 10000
+	for i := 0; i < b.N; i++ {
+		m := NewWithIntComparator()
+		for n := 0; n < size; n++ {
+			m.Put(n, struct{}{})
+		}
+	}
+}
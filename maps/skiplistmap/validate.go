@@ -0,0 +1,44 @@
+package skiplistmap
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// Validate checks the structural invariants of the skip list and returns an error describing the first violation found,
+// or nil if the map is a valid skip list.
+//
+// Checked are that no removed or partially inserted node is left linked, the order of the keys on every level,
+// that every level links exactly the towers tall enough to reach it and the map's size.
+// Validation visits every node and expects no concurrent modifications, so it is meant for tests and debugging.
+func (m *Map) Validate() error {
+	bottom := []*node{}
+	for n := m.head.successor(0); n != nil; n = n.successor(0) {
+		if !n.live() {
+			return fmt.Errorf("skiplistmap: node %v is linked but not in the map", n.key)
+		}
+		if len(bottom) > 0 && m.Comparator(bottom[len(bottom)-1].key, n.key) >= 0 {
+			return fmt.Errorf("skiplistmap: key %v is not smaller than its successor %v", bottom[len(bottom)-1].key, n.key)
+		}
+		bottom = append(bottom, n)
+	}
+	for level := 1; level < maxLevel; level++ {
+		n := m.head.successor(level)
+		for _, expected := range bottom {
+			if len(expected.next) <= level {
+				continue
+			}
+			if n != expected {
+				return fmt.Errorf("skiplistmap: level %v skips the tower of key %v", level, expected.key)
+			}
+			n = n.successor(level)
+		}
+		if n != nil {
+			return fmt.Errorf("skiplistmap: level %v links key %v missing from the bottom level", level, n.key)
+		}
+	}
+	if size := atomic.LoadInt64(&m.size); size != int64(len(bottom)) {
+		return fmt.Errorf("skiplistmap: map has size %v but holds %v elements", size, len(bottom))
+	}
+	return nil
+}
@@ -2,7 +2,7 @@ package treemap
 
 import (
 	"github.com/Arafatk/Dataviz/containers"
-	rbt "github.com/Arafatk/Dataviz/trees/redblacktree"
+	rbt "github.com/riadafridishibly/DataViz/trees/redblacktree"
 )
 
 var _ containers.EnumerableWithKey = (*Map)(nil)
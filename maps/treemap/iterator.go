@@ -2,7 +2,7 @@ package treemap
 
 import (
 	"github.com/Arafatk/Dataviz/containers"
-	rbt "github.com/Arafatk/Dataviz/trees/redblacktree"
+	rbt "github.com/riadafridishibly/DataViz/trees/redblacktree"
 )
 
 var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
@@ -69,3 +69,8 @@ func (iterator *Iterator) First() bool {
 func (iterator *Iterator) Last() bool {
 	return iterator.iterator.Last()
 }
+
+// Err returns an error if the iteration was stopped because the map was modified, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.iterator.Err()
+}
@@ -0,0 +1,49 @@
+package treemap
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the keys and values of the map in-order, for use with range.
+// Panics with redblacktree.ErrConcurrentModification if the map is modified during the iteration.
+func (m *Map) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		it := m.Iterator()
+		for it.Next() {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the map in-order, for use with range.
+func (m *Map) SeqKeys() iter.Seq[any] {
+	return seq.Keys(m.Seq())
+}
+
+// SeqValues returns a sequence of the values of the map in-order based on the key, for use with range.
+func (m *Map) SeqValues() iter.Seq[any] {
+	return seq.Values(m.Seq())
+}
+
+// Backward returns a sequence of the keys and values of the map in reverse order, for use with range.
+// Panics with redblacktree.ErrConcurrentModification if the map is modified during the iteration.
+func (m *Map) Backward() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		it := m.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -1,9 +1,30 @@
 package treemap
 
-import "github.com/Arafatk/Dataviz/containers"
+import (
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
 
 var _ containers.JSONSerializer = (*Map)(nil)
 var _ containers.JSONDeserializer = (*Map)(nil)
+var _ containers.BinarySerializer = (*Map)(nil)
+var _ containers.BinaryDeserializer = (*Map)(nil)
+var _ gob.GobEncoder = (*Map)(nil)
+var _ gob.GobDecoder = (*Map)(nil)
+var _ json.Marshaler = (*Map)(nil)
+var _ json.Unmarshaler = (*Map)(nil)
+
+// SetKeyCodec sets the codec of the keys in the map's JSON representation.
+// With a codec, the map is output as an ordered array of key-value pairs with the keys encoded by the codec,
+// otherwise as a JSON object with the keys converted to strings. See the keycodec package.
+func (m *Map) SetKeyCodec(codec keycodec.KeyCodec) {
+	m.tree.KeyCodec = codec
+}
 
 // ToJSON outputs the JSON representation of list's elements.
 func (m *Map) ToJSON() ([]byte, error) {
@@ -11,6 +32,59 @@ func (m *Map) ToJSON() ([]byte, error) {
 }
 
 // FromJSON populates list's elements from the input JSON representation.
+// The keys of an array of key-value pairs are decoded by the map's key codec, or by keycodec.Typed if it has none,
+// while the keys of a JSON object are restored as strings, so an error is returned if the comparator cannot compare strings.
 func (m *Map) FromJSON(data []byte) error {
 	return m.tree.FromJSON(data)
 }
+
+// MarshalJSON outputs the JSON representation of the map's elements for encoding/json, same as ToJSON.
+func (m *Map) MarshalJSON() ([]byte, error) {
+	return m.ToJSON()
+}
+
+// UnmarshalJSON populates the map's elements from the input JSON representation for encoding/json, same as FromJSON.
+// The map keeps its comparator, so it has to be instantiated with one before decoding into it,
+// e.g. a *Map field of a struct has to be set before decoding the struct, as decoding into a map without a comparator fails.
+func (m *Map) UnmarshalJSON(data []byte) error {
+	if m.tree == nil {
+		return errors.New("treemap: map has no comparator")
+	}
+	return m.FromJSON(data)
+}
+
+// WriteJSON writes the JSON representation of the map's elements to the writer, same as ToJSON.
+// The elements are written one at a time while iterating over the map, see the jsonstream package.
+func (m *Map) WriteJSON(w io.Writer) error {
+	return m.tree.WriteJSON(w)
+}
+
+// ReadJSON populates the map's elements from the JSON representation read from the reader, same as FromJSON.
+func (m *Map) ReadJSON(r io.Reader) error {
+	return m.tree.ReadJSON(r)
+}
+
+// MarshalBinary outputs the compact binary representation of the map's elements in order, see the binarycodec package.
+func (m *Map) MarshalBinary() ([]byte, error) {
+	return m.tree.MarshalBinary()
+}
+
+// UnmarshalBinary populates the map's elements from the input binary representation.
+// The map keeps its comparator, so it has to be instantiated with one before decoding into it.
+func (m *Map) UnmarshalBinary(data []byte) error {
+	if m.tree == nil {
+		return errors.New("treemap: map has no comparator")
+	}
+	return m.tree.UnmarshalBinary(data)
+}
+
+// GobEncode outputs the binary representation of the map's elements for encoding/gob, same as MarshalBinary.
+func (m *Map) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode populates the map's elements from the binary representation for encoding/gob, same as UnmarshalBinary.
+// The map has to be instantiated with a comparator before decoding into it.
+func (m *Map) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
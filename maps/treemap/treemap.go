@@ -12,8 +12,8 @@ import (
 	"strings"
 
 	"github.com/Arafatk/Dataviz/maps"
-	rbt "github.com/Arafatk/Dataviz/trees/redblacktree"
 	"github.com/Arafatk/Dataviz/utils"
+	rbt "github.com/riadafridishibly/DataViz/trees/redblacktree"
 )
 
 var _ maps.Map = (*Map)(nil)
@@ -100,6 +100,77 @@ func (m *Map) Max() (key any, value any) {
 	return nil, nil
 }
 
+// Floor finds the floor key-value pair for the input key.
+// In case that no floor is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if floor was found.
+//
+// Floor key is defined as the largest key that is smaller than or equal to the given key.
+// A floor key may not be found, either because the map is empty, or because
+// all keys in the map are larger than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Floor(key any) (foundKey any, foundValue any) {
+	if node, found := m.tree.Floor(key); found {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Ceiling finds the ceiling key-value pair for the input key.
+// In case that no ceiling is found, then both returned values will be nil.
+// It's generally enough to check the first value (key) for nil, which determines if ceiling was found.
+//
+// Ceiling key is defined as the smallest key that is larger than or equal to the given key.
+// A ceiling key may not be found, either because the map is empty, or because
+// all keys in the map are smaller than the given key.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Ceiling(key any) (foundKey any, foundValue any) {
+	if node, found := m.tree.Ceiling(key); found {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Higher finds the key-value pair with the smallest key strictly larger than the input key.
+// In case that no such key is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Higher(key any) (foundKey any, foundValue any) {
+	if node := m.higher(key); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Lower finds the key-value pair with the largest key strictly smaller than the input key.
+// In case that no such key is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Lower(key any) (foundKey any, foundValue any) {
+	if node := m.lower(key); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Split moves all elements of the map into two new maps, the left one holding the keys smaller than the given key
+// and the right one holding the keys larger than or equal to the given key. The map is left empty.
+// Split takes O(log n) time.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) Split(key any) (left *Map, right *Map) {
+	l, r := m.tree.Split(key)
+	return &Map{tree: l}, &Map{tree: r}
+}
+
+// Join moves all elements of the other map into the map.
+// All keys of the map must be smaller than all keys of the other map, otherwise an error is returned and both maps are left unchanged.
+// The other map is left empty. Join takes O(log n) time.
+func (m *Map) Join(other *Map) error {
+	return m.tree.Join(other.tree)
+}
+
 // String returns a string representation of container
 func (m *Map) String() string {
 	str := "TreeMap\nmap["
@@ -116,3 +187,61 @@ func (m *Map) String() string {
 func (m *Map) Visualizer(fileName string) bool {
 	return m.tree.Visualizer(fileName)
 }
+
+func (m *Map) higher(key any) *rbt.Node {
+	var higher *rbt.Node
+	node := m.tree.Root
+	for node != nil {
+		if m.tree.Comparator(key, node.Key) < 0 {
+			higher = node
+			node = node.Left
+		} else {
+			node = node.Right
+		}
+	}
+	return higher
+}
+
+func (m *Map) lower(key any) *rbt.Node {
+	var lower *rbt.Node
+	node := m.tree.Root
+	for node != nil {
+		if m.tree.Comparator(key, node.Key) > 0 {
+			lower = node
+			node = node.Right
+		} else {
+			node = node.Left
+		}
+	}
+	return lower
+}
+
+// successor returns the in-order successor of node or nil if node is the right-most node.
+func successor(node *rbt.Node) *rbt.Node {
+	if node.Right != nil {
+		node = node.Right
+		for node.Left != nil {
+			node = node.Left
+		}
+		return node
+	}
+	for node.Parent != nil && node == node.Parent.Right {
+		node = node.Parent
+	}
+	return node.Parent
+}
+
+// predecessor returns the in-order predecessor of node or nil if node is the left-most node.
+func predecessor(node *rbt.Node) *rbt.Node {
+	if node.Left != nil {
+		node = node.Left
+		for node.Right != nil {
+			node = node.Right
+		}
+		return node
+	}
+	for node.Parent != nil && node == node.Parent.Left {
+		node = node.Parent
+	}
+	return node.Parent
+}
@@ -1,8 +1,15 @@
 package treemap
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 func TestMapPut(t *testing.T) {
@@ -467,6 +474,378 @@ func TestMapSerialization(t *testing.T) {
 	assert()
 }
 
+func TestMapKeyCodec(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(10, "b")
+	m.Put(9, "a")
+	m.SetKeyCodec(keycodec.JSON[int]{})
+	json, err := m.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `[{"key":9,"value":"a"},{"key":10,"value":"b"}]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	loaded := NewWithIntComparator()
+	loaded.SetKeyCodec(keycodec.JSON[int]{})
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := loaded.String(), "TreeMap\nmap[9:a 10:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := loaded.Get(10); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// without a codec the keys are restored as strings, which the IntComparator cannot compare
+	m.SetKeyCodec(nil)
+	if json, err = m.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `{"9":"a","10":"b"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.FromJSON(json); err == nil {
+		t.Errorf("Expected error for string keys of a map with the IntComparator")
+	}
+	if actualValue, expectedValue := loaded.String(), "TreeMap\nmap[9:a 10:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapBinary(t *testing.T) {
+	m := NewWithStringComparator()
+	m.Put("b", 2)
+	m.Put("a", 1.5)
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(m); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := NewWithStringComparator()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := decoded.String(), "TreeMap\nmap[a:1.5 b:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := decoded.Get("b"); actualValue != 2 {
+		t.Errorf("Got %T expected an int", actualValue)
+	}
+
+	data, err := m.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := (&Map{}).UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for a map without a comparator")
+	}
+}
+
+func TestMapWriteReadJSON(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(2, "b")
+	m.Put(1, "a")
+	m.SetKeyCodec(keycodec.JSON[int]{})
+	var buffer bytes.Buffer
+	if err := m.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator()
+	loaded.SetKeyCodec(keycodec.JSON[int]{})
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := loaded.String(), "TreeMap\nmap[1:a 2:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		Map  *Map
+	}
+	m := NewWithStringComparator()
+	m.Put("c", 3.0)
+	m.Put("a", 1.0)
+	m.Put("b", 2.0)
+	data, err := json.Marshal(document{Name: "letters", Map: m})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Map":{"a":1,"b":2,"c":3}}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	decoded := document{Map: NewWithStringComparator()}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Map.Keys(), decoded.Map.Values()), "[a b c] [1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a map without a comparator")
+	}
+}
+
+func TestMapFloorCeilingHigherLower(t *testing.T) {
+	m := NewWithIntComparator()
+	m.Put(2, "b")
+	m.Put(4, "d")
+	m.Put(6, "f")
+
+	// key, floor, ceiling, higher, lower
+	tests := [][]any{
+		{1, nil, 2, 2, nil},
+		{2, 2, 2, 4, nil},
+		{3, 2, 4, 4, 2},
+		{6, 6, 6, nil, 4},
+		{7, 6, nil, nil, 6},
+	}
+	for _, test := range tests {
+		if actualValue, _ := m.Floor(test[0]); actualValue != test[1] {
+			t.Errorf("Floor(%v): got %v expected %v", test[0], actualValue, test[1])
+		}
+		if actualValue, _ := m.Ceiling(test[0]); actualValue != test[2] {
+			t.Errorf("Ceiling(%v): got %v expected %v", test[0], actualValue, test[2])
+		}
+		if actualValue, _ := m.Higher(test[0]); actualValue != test[3] {
+			t.Errorf("Higher(%v): got %v expected %v", test[0], actualValue, test[3])
+		}
+		if actualValue, _ := m.Lower(test[0]); actualValue != test[4] {
+			t.Errorf("Lower(%v): got %v expected %v", test[0], actualValue, test[4])
+		}
+	}
+}
+
+func TestMapSubMap(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 1; i <= 9; i++ {
+		m.Put(i, i*10)
+	}
+
+	tests := []struct {
+		view     *View
+		expected string
+	}{
+		{m.SubMap(3, true, 6, false), "[3 4 5]"},
+		{m.SubMap(3, false, 6, true), "[4 5 6]"},
+		{m.SubMap(0, true, 100, true), "[1 2 3 4 5 6 7 8 9]"},
+		{m.SubMap(6, true, 3, true), "[]"},
+		{m.HeadMap(3, true), "[1 2 3]"},
+		{m.HeadMap(3, false), "[1 2]"},
+		{m.TailMap(7, true), "[7 8 9]"},
+		{m.TailMap(7, false), "[8 9]"},
+		{m.TailMap(9, false), "[]"},
+	}
+	for _, test := range tests {
+		if actualValue := fmt.Sprintf("%v", test.view.Keys()); actualValue != test.expected {
+			t.Errorf("Got %v expected %v", actualValue, test.expected)
+		}
+		if actualValue, expectedValue := test.view.Size(), len(test.view.Keys()); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+
+	view := m.SubMap(3, true, 6, false)
+	if actualValue, found := view.Get(4); actualValue != 40 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 40)
+	}
+	if actualValue, found := view.Get(6); actualValue != nil || found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if key, value := view.Min(); key != 3 || value != 30 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 3, 30)
+	}
+	if key, value := view.Max(); key != 5 || value != 50 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 5, 50)
+	}
+	if key, _ := view.Floor(100); key != 5 {
+		t.Errorf("Got %v expected %v", key, 5)
+	}
+	if key, _ := view.Ceiling(0); key != 3 {
+		t.Errorf("Got %v expected %v", key, 3)
+	}
+	if key, _ := view.Higher(5); key != nil {
+		t.Errorf("Got %v expected %v", key, nil)
+	}
+	if key, _ := view.Lower(3); key != nil {
+		t.Errorf("Got %v expected %v", key, nil)
+	}
+
+	// changes are shared with the underlying map
+	m.Remove(4)
+	view.Put(5, 55)
+	view.Remove(7)
+	if actualValue, expectedValue := fmt.Sprintf("%v", view.Values()), "[30 55]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := m.Get(5); actualValue != 55 {
+		t.Errorf("Got %v expected %v", actualValue, 55)
+	}
+	if actualValue, found := m.Get(7); actualValue != 70 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 70)
+	}
+	view.Clear()
+	if actualValue, expectedValue := fmt.Sprintf("%v", m.Keys()), "[1 2 6 7 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := view.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Put outside of the view's range should panic")
+		}
+	}()
+	view.Put(6, 60)
+}
+
+func TestMapViewIterator(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 1; i <= 9; i++ {
+		m.Put(i, i*10)
+	}
+	view := m.SubMap(3, true, 7, true)
+
+	it := view.Iterator()
+	keys := []any{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[3 4 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	keys = []any{}
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[7 6 5 4 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// key, expectedFound, expectedKey
+	tests := [][]any{
+		{0, true, 3},
+		{5, true, 5},
+		{7, true, 7},
+		{8, false, nil},
+	}
+	for _, test := range tests {
+		found := it.Seek(test[0])
+		if found != test[1] {
+			t.Errorf("Seek(%v): got %v expected %v", test[0], found, test[1])
+		}
+		if found && it.Key() != test[2] {
+			t.Errorf("Seek(%v): got %v expected %v", test[0], it.Key(), test[2])
+		}
+	}
+
+	m.Remove(5)
+	it.Seek(5)
+	if key, value := it.Key(), it.Value(); key != 6 || value != 60 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 6, 60)
+	}
+	it.Next()
+	if actualValue, expectedValue := it.Next(), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue || it.Key() != 7 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 7)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue || it.Key() != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 3)
+	}
+
+	empty := m.SubMap(20, true, 30, true).Iterator()
+	for empty.Next() {
+		t.Errorf("Shouldn't iterate on empty view")
+	}
+	for empty.Prev() {
+		t.Errorf("Shouldn't iterate on empty view")
+	}
+}
+
+func TestMapSplitJoin(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 0; i < 10; i++ {
+		m.Put(i, fmt.Sprintf("%d", i))
+	}
+	left, right := m.Split(4)
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := fmt.Sprint(left.Keys()), "[0 1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(right.Keys()), "[4 5 6 7 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := right.Get(4); actualValue != "4" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "4")
+	}
+
+	if err := right.Join(left); err == nil {
+		t.Errorf("Expected an error joining overlapping maps")
+	}
+	if err := left.Join(right); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(left.Keys()), "[0 1 2 3 4 5 6 7 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := right.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, _ := left.Max(); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	m := NewWithIntComparator()
+	for i := 5; i > 0; i-- {
+		m.Put(i, fmt.Sprint(i*10))
+	}
+	actual := []string{}
+	for key, value := range m.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[1:10 2:20 3:30 4:40 5:50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(m.SeqKeys())), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(m.SeqValues())), "[10 20 30 40 50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for key, value := range m.Backward() {
+		if key == 2 {
+			break
+		}
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[5:50 4:40 3:30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzMap(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckMap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func benchmarkGet(b *testing.B, m *Map, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -619,3 +998,33 @@ func BenchmarkTreeMapRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, m, size)
 }
+
+func BenchmarkTreeMapToJSON100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.ToJSON(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkTreeMapMarshalBinary100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	m := NewWithIntComparator()
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := m.MarshalBinary(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,392 @@
+package treemap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Arafatk/Dataviz/containers"
+	"github.com/Arafatk/Dataviz/maps"
+	rbt "github.com/riadafridishibly/DataViz/trees/redblacktree"
+)
+
+var _ maps.Map = (*View)(nil)
+var _ containers.ReverseIteratorWithKey = (*ViewIterator)(nil)
+
+// View is a range-restricted view of a tree map.
+//
+// A view does not copy any elements, it is backed by the red-black tree of the map it was created from.
+// Changes to the map within the view's range are visible through the view and vice versa.
+type View struct {
+	m    *Map
+	from *bound
+	to   *bound
+}
+
+// bound is a lower or upper limit of a view's key range.
+type bound struct {
+	key       any
+	inclusive bool
+}
+
+// SubMap returns a view of the portion of the map whose keys range from fromKey to toKey.
+// Each bound is included in the view if its corresponding inclusive flag is true.
+// If fromKey is larger than toKey, then the view is empty.
+//
+// Keys should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) SubMap(fromKey any, fromInclusive bool, toKey any, toInclusive bool) *View {
+	return &View{m: m, from: &bound{fromKey, fromInclusive}, to: &bound{toKey, toInclusive}}
+}
+
+// HeadMap returns a view of the portion of the map whose keys are smaller than
+// (or equal to, if inclusive is true) toKey.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) HeadMap(toKey any, inclusive bool) *View {
+	return &View{m: m, to: &bound{toKey, inclusive}}
+}
+
+// TailMap returns a view of the portion of the map whose keys are larger than
+// (or equal to, if inclusive is true) fromKey.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (m *Map) TailMap(fromKey any, inclusive bool) *View {
+	return &View{m: m, from: &bound{fromKey, inclusive}}
+}
+
+// Put inserts key-value pair into the underlying map.
+// Key should lie within the view's range and adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Put(key any, value any) {
+	if !v.inRange(key) {
+		panic(fmt.Sprintf("treemap: key %v is out of the view's range", key))
+	}
+	v.m.Put(key, value)
+}
+
+// Get searches the element in the view by key and returns its value or nil if key is not found in the view.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Get(key any) (value any, found bool) {
+	if !v.inRange(key) {
+		return nil, false
+	}
+	return v.m.Get(key)
+}
+
+// Remove removes the element from the underlying map by key.
+// Keys outside of the view's range are ignored.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Remove(key any) {
+	if v.inRange(key) {
+		v.m.Remove(key)
+	}
+}
+
+// Empty returns true if the view does not contain any elements
+func (v *View) Empty() bool {
+	return v.first() == nil
+}
+
+// Size returns number of elements in the view.
+// Elements are counted on every call, so the operation is linear in the size of the view.
+func (v *View) Size() int {
+	size := 0
+	for node := v.first(); node != nil; node = v.next(node) {
+		size++
+	}
+	return size
+}
+
+// Keys returns all keys of the view in-order
+func (v *View) Keys() []any {
+	keys := []any{}
+	for node := v.first(); node != nil; node = v.next(node) {
+		keys = append(keys, node.Key)
+	}
+	return keys
+}
+
+// Values returns all values of the view in-order based on the key.
+func (v *View) Values() []any {
+	values := []any{}
+	for node := v.first(); node != nil; node = v.next(node) {
+		values = append(values, node.Value)
+	}
+	return values
+}
+
+// Clear removes all elements within the view's range from the underlying map.
+func (v *View) Clear() {
+	for _, key := range v.Keys() {
+		v.m.Remove(key)
+	}
+}
+
+// Min returns the minimum key and its value from the view.
+// Returns nil, nil if view is empty.
+func (v *View) Min() (key any, value any) {
+	if node := v.first(); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Max returns the maximum key and its value from the view.
+// Returns nil, nil if view is empty.
+func (v *View) Max() (key any, value any) {
+	if node := v.last(); node != nil {
+		return node.Key, node.Value
+	}
+	return nil, nil
+}
+
+// Floor finds the largest key-value pair in the view whose key is smaller than or equal to the given key.
+// In case that no floor is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Floor(key any) (foundKey any, foundValue any) {
+	node, _ := v.m.tree.Floor(key)
+	return v.within(v.clampTo(node))
+}
+
+// Ceiling finds the smallest key-value pair in the view whose key is larger than or equal to the given key.
+// In case that no ceiling is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Ceiling(key any) (foundKey any, foundValue any) {
+	node, _ := v.m.tree.Ceiling(key)
+	return v.within(v.clampFrom(node))
+}
+
+// Higher finds the smallest key-value pair in the view whose key is strictly larger than the given key.
+// In case that no such key is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Higher(key any) (foundKey any, foundValue any) {
+	return v.within(v.clampFrom(v.m.higher(key)))
+}
+
+// Lower finds the largest key-value pair in the view whose key is strictly smaller than the given key.
+// In case that no such key is found, then both returned values will be nil.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (v *View) Lower(key any) (foundKey any, foundValue any) {
+	return v.within(v.clampTo(v.m.lower(key)))
+}
+
+// String returns a string representation of container
+func (v *View) String() string {
+	str := "TreeMapView\nmap["
+	for node := v.first(); node != nil; node = v.next(node) {
+		str += fmt.Sprintf("%v:%v ", node.Key, node.Value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
+
+// first returns the left-most node within the view's range or nil if the view is empty.
+func (v *View) first() *rbt.Node {
+	var node *rbt.Node
+	switch {
+	case v.from == nil:
+		node = v.m.tree.Left()
+	case v.from.inclusive:
+		node, _ = v.m.tree.Ceiling(v.from.key)
+	default:
+		node = v.m.higher(v.from.key)
+	}
+	if node == nil || !v.belowTo(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// last returns the right-most node within the view's range or nil if the view is empty.
+func (v *View) last() *rbt.Node {
+	var node *rbt.Node
+	switch {
+	case v.to == nil:
+		node = v.m.tree.Right()
+	case v.to.inclusive:
+		node, _ = v.m.tree.Floor(v.to.key)
+	default:
+		node = v.m.lower(v.to.key)
+	}
+	if node == nil || !v.aboveFrom(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// next returns the in-order successor of node if it lies within the view's range, otherwise nil.
+func (v *View) next(node *rbt.Node) *rbt.Node {
+	if node = successor(node); node == nil || !v.belowTo(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// prev returns the in-order predecessor of node if it lies within the view's range, otherwise nil.
+func (v *View) prev(node *rbt.Node) *rbt.Node {
+	if node = predecessor(node); node == nil || !v.aboveFrom(node.Key) {
+		return nil
+	}
+	return node
+}
+
+// clampFrom replaces a node lying below the view's range with the view's first node.
+func (v *View) clampFrom(node *rbt.Node) *rbt.Node {
+	if node != nil && !v.aboveFrom(node.Key) {
+		return v.first()
+	}
+	return node
+}
+
+// clampTo replaces a node lying above the view's range with the view's last node.
+func (v *View) clampTo(node *rbt.Node) *rbt.Node {
+	if node != nil && !v.belowTo(node.Key) {
+		return v.last()
+	}
+	return node
+}
+
+func (v *View) within(node *rbt.Node) (key any, value any) {
+	if node == nil || !v.inRange(node.Key) {
+		return nil, nil
+	}
+	return node.Key, node.Value
+}
+
+func (v *View) inRange(key any) bool {
+	return v.aboveFrom(key) && v.belowTo(key)
+}
+
+func (v *View) aboveFrom(key any) bool {
+	if v.from == nil {
+		return true
+	}
+	compare := v.m.tree.Comparator(key, v.from.key)
+	return compare > 0 || (compare == 0 && v.from.inclusive)
+}
+
+func (v *View) belowTo(key any) bool {
+	if v.to == nil {
+		return true
+	}
+	compare := v.m.tree.Comparator(key, v.to.key)
+	return compare < 0 || (compare == 0 && v.to.inclusive)
+}
+
+// ViewIterator holding the iterator's state
+type ViewIterator struct {
+	view     *View
+	node     *rbt.Node
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator over the view whose elements are key/value pairs.
+func (v *View) Iterator() ViewIterator {
+	return ViewIterator{view: v, node: nil, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the view.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Next() bool {
+	switch iterator.position {
+	case begin:
+		iterator.node = iterator.view.first()
+	case between:
+		iterator.node = iterator.view.next(iterator.node)
+	case end:
+		iterator.node = nil
+	}
+	return iterator.settle(end)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the view.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Prev() bool {
+	switch iterator.position {
+	case begin:
+		iterator.node = nil
+	case between:
+		iterator.node = iterator.view.prev(iterator.node)
+	case end:
+		iterator.node = iterator.view.last()
+	}
+	return iterator.settle(begin)
+}
+
+// Seek moves the iterator to the first element in the view whose key is larger than or equal to the given key
+// and returns true if there was such an element.
+// Otherwise the iterator is moved past the last element (one-past-the-end).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *ViewIterator) Seek(key any) bool {
+	node, _ := iterator.view.m.tree.Ceiling(key)
+	if node = iterator.view.clampFrom(node); node != nil && !iterator.view.inRange(node.Key) {
+		node = nil
+	}
+	iterator.node = node
+	return iterator.settle(end)
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator) Value() any {
+	return iterator.node.Value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *ViewIterator) Key() any {
+	return iterator.node.Key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *ViewIterator) Begin() {
+	iterator.node = nil
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *ViewIterator) End() {
+	iterator.node = nil
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the view.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *ViewIterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the view.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *ViewIterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// settle updates the iterator's position after its node was moved, using exhausted when no node is left.
+func (iterator *ViewIterator) settle(exhausted position) bool {
+	if iterator.node == nil {
+		iterator.position = exhausted
+		return false
+	}
+	iterator.position = between
+	return true
+}
@@ -0,0 +1,70 @@
+package lockfreequeue
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.IteratorWithIndex = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	queue *Queue
+	node  *node
+	value any
+	index int
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+// The iterator is weakly consistent, see the package documentation.
+func (queue *Queue) Iterator() Iterator {
+	return Iterator{queue: queue, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	if iterator.index == -1 {
+		iterator.node = iterator.queue.head.Load()
+	}
+	for iterator.node != nil {
+		if iterator.node = iterator.node.next.Load(); iterator.node == nil {
+			break
+		}
+		// skip the nodes dequeued meanwhile
+		if stored := iterator.node.value.Load(); stored != nil {
+			iterator.value = *stored
+			iterator.index++
+			return true
+		}
+	}
+	iterator.index = -2
+	return false
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() any {
+	return iterator.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.node = nil
+	iterator.value = nil
+	iterator.index = -1
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
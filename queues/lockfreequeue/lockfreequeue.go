@@ -0,0 +1,157 @@
+// Package lockfreequeue implements a lock-free queue (Michael-Scott queue).
+//
+// Structure is thread safe. Enqueue and Dequeue never block, they retry a compare-and-swap of the tail or head
+// of the queue instead, helping a lagging tail along when they find one.
+// Values, String and iterators walk the queue while it may be modified concurrently, so they are weakly consistent:
+// they see every element present for the whole walk and possibly some enqueued or dequeued meanwhile.
+// Size is maintained separately and may lag behind concurrent operations.
+//
+// Reference: https://www.cs.rochester.edu/~scott/papers/1996_PODC_queues.pdf
+package lockfreequeue
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/riadafridishibly/DataViz/queues"
+)
+
+var _ queues.Queue = (*Queue)(nil)
+
+// Queue holds elements in a singly-linked list headed by a dummy node
+type Queue struct {
+	head atomic.Pointer[node] // the dummy node, its successor is the front of the queue
+	tail atomic.Pointer[node] // the last node or, transiently, its predecessor
+	size atomic.Int64
+}
+
+type node struct {
+	value atomic.Pointer[any] // nil once the node is dequeued and became the dummy node
+	next  atomic.Pointer[node]
+}
+
+// New instantiates a new empty queue
+func New() *Queue {
+	queue, dummy := &Queue{}, &node{}
+	queue.head.Store(dummy)
+	queue.tail.Store(dummy)
+	return queue
+}
+
+// Enqueue adds a value to the end of the queue
+func (queue *Queue) Enqueue(value any) {
+	n := &node{}
+	n.value.Store(&value)
+	for {
+		tail := queue.tail.Load()
+		next := tail.next.Load()
+		if tail != queue.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// tail is lagging behind, help swinging it before retrying
+			queue.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			queue.tail.CompareAndSwap(tail, n)
+			queue.size.Add(1)
+			return
+		}
+	}
+}
+
+// Dequeue removes first element of the queue and returns it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue) Dequeue() (value any, ok bool) {
+	for {
+		head := queue.head.Load()
+		tail := queue.tail.Load()
+		next := head.next.Load()
+		if head != queue.head.Load() {
+			continue
+		}
+		if next == nil {
+			return nil, false
+		}
+		if head == tail {
+			queue.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// read the value before the swap, afterwards next is the new dummy node
+		stored := next.value.Load()
+		if stored == nil {
+			continue // next was dequeued meanwhile
+		}
+		if queue.head.CompareAndSwap(head, next) {
+			// the dummy node must not keep the value alive
+			next.value.Store(nil)
+			queue.size.Add(-1)
+			return *stored, true
+		}
+	}
+}
+
+// Peek returns first element of the queue without removing it, or nil if queue is empty.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue) Peek() (value any, ok bool) {
+	for {
+		first := queue.first()
+		if first == nil {
+			return nil, false
+		}
+		if stored := first.value.Load(); stored != nil {
+			return *stored, true
+		}
+		// first was dequeued meanwhile, retry with the new front
+	}
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue) Empty() bool {
+	return queue.first() == nil
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue) Size() int {
+	if size := queue.size.Load(); size > 0 {
+		return int(size)
+	}
+	return 0
+}
+
+// Clear removes all elements from the queue.
+// Elements enqueued concurrently may or may not be removed.
+func (queue *Queue) Clear() {
+	for {
+		if _, ok := queue.Dequeue(); !ok {
+			return
+		}
+	}
+}
+
+// Values returns all elements in the queue (FIFO order).
+func (queue *Queue) Values() []any {
+	values := []any{}
+	for it := queue.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (queue *Queue) String() string {
+	str := "LockFreeQueue\n"
+	values := []string{}
+	for _, value := range queue.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// first returns the node holding the front of the queue, or nil if queue is empty.
+func (queue *Queue) first() *node {
+	return queue.head.Load().next.Load()
+}
@@ -0,0 +1,270 @@
+package lockfreequeue
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/stacks/arraystack"
+	"github.com/riadafridishibly/DataViz/synchronized"
+)
+
+func TestQueueEnqueue(t *testing.T) {
+	queue := New()
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+
+	if actualValue, expectedValue := fmt.Sprint(queue.Values()), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := queue.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestQueueDequeue(t *testing.T) {
+	queue := New()
+	if actualValue, ok := queue.Peek(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	queue.Enqueue(1)
+	queue.Enqueue(2)
+	queue.Enqueue(3)
+	queue.Dequeue()
+	if actualValue, ok := queue.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := queue.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	queue.Enqueue(4)
+	if actualValue, ok := queue.Dequeue(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := queue.head.Load().value.Load(); actualValue != nil {
+		t.Errorf("Got %v expected the dummy node not to hold the dequeued value", *actualValue)
+	}
+	queue.Enqueue(nil)
+	if actualValue, ok := queue.Peek(); actualValue != nil || !ok {
+		t.Errorf("Got %v, %v expected %v, %v", actualValue, ok, nil, true)
+	}
+}
+
+func TestQueueClear(t *testing.T) {
+	queue := New()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Clear()
+	if actualValue := queue.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := queue.String(), "LockFreeQueue\n"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+	queue.Enqueue("c")
+	queue.Enqueue("d")
+	if actualValue, expectedValue := queue.String(), "LockFreeQueue\nc, d"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+}
+
+func TestQueueIterator(t *testing.T) {
+	queue := New()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+
+	it := queue.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		index, value := it.Index(), it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	queue.Dequeue()
+	if !it.First() || it.Index() != 0 || it.Value() != "b" {
+		t.Errorf("Got %v:%v expected %v:%v", it.Index(), it.Value(), 0, "b")
+	}
+	if it := New().Iterator(); it.Next() || it.First() {
+		t.Errorf("Shouldn't iterate on empty queue")
+	}
+}
+
+func TestQueueConcurrent(t *testing.T) {
+	const workers, iterations = 8, 1000
+	queue := New()
+	var wg sync.WaitGroup
+	dequeued := make([][]int, workers)
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(2)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				queue.Enqueue(worker*iterations + i)
+			}
+		}(worker)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if value, ok := queue.Dequeue(); ok {
+					dequeued[worker] = append(dequeued[worker], value.(int))
+				}
+				queue.Peek()
+				queue.Size()
+			}
+		}(worker)
+	}
+	wg.Wait()
+	for value, ok := queue.Dequeue(); ok; value, ok = queue.Dequeue() {
+		dequeued[0] = append(dequeued[0], value.(int))
+	}
+	seen := make([]bool, workers*iterations)
+	for _, values := range dequeued {
+		// values enqueued by the same producer are dequeued in their order by every consumer
+		last := make([]int, workers)
+		for i := range last {
+			last[i] = -1
+		}
+		for _, value := range values {
+			if seen[value] {
+				t.Fatalf("Value %v dequeued twice", value)
+			}
+			seen[value] = true
+			producer, i := value/iterations, value%iterations
+			if i <= last[producer] {
+				t.Fatalf("Value %v dequeued after %v", value, producer*iterations+last[producer])
+			}
+			last[producer] = i
+		}
+	}
+	for value, ok := range seen {
+		if !ok {
+			t.Fatalf("Value %v was not dequeued", value)
+		}
+	}
+	if actualValue := queue.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestQueueMarshalJSON(t *testing.T) {
+	type document struct {
+		Name  string
+		Queue *Queue
+	}
+	queue := New()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	data, err := json.Marshal(document{Name: "letters", Queue: queue})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Queue":["a","b","c"]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var decoded document
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Queue.Values()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if value, ok := decoded.Queue.Dequeue(); value != "a" || !ok {
+		t.Errorf("Got %v,%v expected %v,%v", value, ok, "a", true)
+	}
+}
+
+func TestQueueSeq(t *testing.T) {
+	queue := New()
+	queue.Enqueue("a")
+	queue.Enqueue("b")
+	queue.Enqueue("c")
+	actual := []string{}
+	for index, value := range queue.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:a 1:b 2:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(queue.SeqValues())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+// benchmarkContended adds and removes from all goroutines at once, so that they compete for both ends of the queue.
+func benchmarkContended(b *testing.B, add func(value any), remove func() (any, bool)) {
+	for n := 0; n < 1000; n++ {
+		add(n)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if i%2 == 0 {
+				add(i)
+			} else {
+				remove()
+			}
+		}
+	})
+}
+
+func BenchmarkLockFreeQueueContended(b *testing.B) {
+	queue := New()
+	benchmarkContended(b, queue.Enqueue, queue.Dequeue)
+}
+
+func BenchmarkArrayStackContended(b *testing.B) {
+	stack := synchronized.NewStack(arraystack.New())
+	benchmarkContended(b, stack.Push, stack.Pop)
+}
+
+func BenchmarkLockFreeQueueEnqueue(b *testing.B) {
+	queue := New()
+	for n := 0; n < b.N; n++ {
+		queue.Enqueue(n)
+	}
+}
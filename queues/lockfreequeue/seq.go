@@ -0,0 +1,25 @@
+package lockfreequeue
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the indices and values of the queue from front to back, for use with range.
+// Like iterators, the sequence is weakly consistent.
+func (queue *Queue) Seq() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		for it := queue.Iterator(); it.Next(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqValues returns a sequence of the values of the queue from front to back, for use with range.
+// Like iterators, the sequence is weakly consistent.
+func (queue *Queue) SeqValues() iter.Seq[any] {
+	return seq.Values(queue.Seq())
+}
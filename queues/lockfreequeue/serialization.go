@@ -0,0 +1,55 @@
+package lockfreequeue
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+)
+
+var _ containers.JSONSerializer = (*Queue)(nil)
+var _ containers.JSONDeserializer = (*Queue)(nil)
+var _ json.Marshaler = (*Queue)(nil)
+var _ json.Unmarshaler = (*Queue)(nil)
+
+// ToJSON outputs the JSON representation of the queue's elements from the front to the back.
+// Like iterators, the output is weakly consistent.
+func (queue *Queue) ToJSON() ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := jsonstream.WriteArray(&buffer, queue.SeqValues()); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// FromJSON populates the queue's elements from the input JSON representation, enqueueing them from the front to the back.
+// The queue is cleared and refilled element by element, so concurrent operations may interleave with the refill.
+func (queue *Queue) FromJSON(data []byte) error {
+	values, err := jsonstream.ReadArray[any](bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	queue.Clear()
+	for _, value := range values {
+		queue.Enqueue(value)
+	}
+	return nil
+}
+
+// MarshalJSON outputs the JSON representation of the queue's elements for encoding/json, same as ToJSON.
+func (queue *Queue) MarshalJSON() ([]byte, error) {
+	return queue.ToJSON()
+}
+
+// UnmarshalJSON populates the queue's elements from the input JSON representation for encoding/json, same as FromJSON.
+// A zero Queue, e.g. one allocated by encoding/json for a nil *Queue field, is initialized before decoding into it,
+// which must not happen concurrently with other operations.
+func (queue *Queue) UnmarshalJSON(data []byte) error {
+	if queue.head.Load() == nil {
+		dummy := &node{}
+		queue.head.Store(dummy)
+		queue.tail.Store(dummy)
+	}
+	return queue.FromJSON(data)
+}
@@ -0,0 +1,21 @@
+// Package queues provides an abstract Queue interface.
+//
+// In computer science, a queue is a collection of elements kept in order, with two principal operations: enqueue, which adds an element to the rear of the queue, and dequeue, which removes the element at the front of the queue. The order in which elements come off a queue gives rise to its alternative name, FIFO (first in, first out). Additionally, a peek operation may give access to the front without modifying the queue.
+//
+// Reference: https://en.wikipedia.org/wiki/Queue_(abstract_data_type)
+package queues
+
+import "github.com/Arafatk/Dataviz/containers"
+
+// Queue interface that all queues implement
+type Queue interface {
+	Enqueue(value any)
+	Dequeue() (value any, ok bool)
+	Peek() (value any, ok bool)
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+}
@@ -1,8 +1,15 @@
 package arraystack
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
 )
 
 func TestStackPush(t *testing.T) {
@@ -256,6 +263,125 @@ func TestStackSerialization(t *testing.T) {
 	assert()
 }
 
+func TestStackBinary(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push("b")
+	stack.Push(nil)
+	data, err := stack.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := New()
+	loaded.Push(0)
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Values()), "[<nil> b 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(stack); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := New()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, _ := decoded.Peek(); actualValue != nil || decoded.Size() != 3 {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestStackWriteReadJSON(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	var buffer bytes.Buffer
+	if err := stack.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	json, _ := stack.ToJSON()
+	if actualValue, expectedValue := buffer.String(), string(json); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := New()
+	loaded.Push("x")
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, _ := loaded.Pop(); actualValue != "b" || loaded.Size() != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if err := loaded.ReadJSON(strings.NewReader(`{}`)); err == nil {
+		t.Errorf("Expected error for non-array input")
+	}
+}
+
+func TestStackMarshalJSON(t *testing.T) {
+	type document struct {
+		Name  string
+		Stack *Stack
+	}
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	data, err := json.Marshal(document{Name: "letters", Stack: stack})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Stack":["a","b","c"]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var decoded document
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Stack.Values()), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackSeq(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	actual := []string{}
+	for index, value := range stack.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:c 1:b 2:a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(stack.SeqValues())), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for index, value := range stack.Backward() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+		if index == 1 {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:a 1:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzStack(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckStack(New(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func benchmarkPush(b *testing.B, stack *Stack, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -272,6 +398,47 @@ func benchmarkPop(b *testing.B, stack *Stack, size int) {
 	}
 }
 
+func TestStackEnumerable(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	var values []any
+	stack.Each(func(index int, value any) { values = append(values, fmt.Sprint(index, ":", value)) })
+	if actualValue, expectedValue := fmt.Sprint(values), "[0:3 1:2 2:1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := stack.Map(func(index int, value any) any { return value.(int) * 10 })
+	if actualValue, expectedValue := fmt.Sprint(mapped.Values()), "[30 20 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, _ := mapped.Pop(); actualValue != 30 {
+		t.Errorf("Got %v expected %v", actualValue, 30)
+	}
+
+	odd := stack.Select(func(index int, value any) bool { return value.(int)%2 == 1 })
+	if actualValue, expectedValue := fmt.Sprint(odd.Values()), "[3 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := stack.Any(func(index int, value any) bool { return value == 2 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := stack.All(func(index int, value any) bool { return value.(int) < 3 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	index, value := stack.Find(func(index int, value any) bool { return value.(int) < 3 })
+	if index != 1 || value != 2 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 1, 2)
+	}
+	index, value = stack.Find(func(index int, value any) bool { return value.(int) > 3 })
+	if index != -1 || value != nil {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, -1, nil)
+	}
+}
+
 func BenchmarkArrayStackPop100(b *testing.B) {
 	b.StopTimer()
 	size := 100
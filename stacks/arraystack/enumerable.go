@@ -0,0 +1,76 @@
+package arraystack
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.EnumerableWithIndex = (*Stack)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+// Elements are visited from the top to the bottom of the stack.
+func (stack *Stack) Each(f func(index int, value any)) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// The returned stack holds the values in the same order, i.e. the value returned for the top element is on top.
+func (stack *Stack) Map(f func(index int, value any) any) *Stack {
+	newStack := New()
+	iterator := stack.Iterator()
+	for iterator.End(); iterator.Prev(); {
+		newStack.Push(f(iterator.Index(), iterator.Value()))
+	}
+	return newStack
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// The returned stack holds the elements in the same order.
+func (stack *Stack) Select(f func(index int, value any) bool) *Stack {
+	newStack := New()
+	iterator := stack.Iterator()
+	for iterator.End(); iterator.Prev(); {
+		if f(iterator.Index(), iterator.Value()) {
+			newStack.Push(iterator.Value())
+		}
+	}
+	return newStack
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (stack *Stack) Any(f func(index int, value any) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (stack *Stack) All(f func(index int, value any) bool) bool {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (stack *Stack) Find(f func(index int, value any) bool) (int, any) {
+	iterator := stack.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
@@ -0,0 +1,35 @@
+package arraystack
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the indices and values of the stack from top to bottom, for use with range.
+func (stack *Stack) Seq() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		for it := stack.Iterator(); it.Next(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqValues returns a sequence of the values of the stack from top to bottom, for use with range.
+func (stack *Stack) SeqValues() iter.Seq[any] {
+	return seq.Values(stack.Seq())
+}
+
+// Backward returns a sequence of the indices and values of the stack from bottom to top, for use with range.
+func (stack *Stack) Backward() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		it := stack.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
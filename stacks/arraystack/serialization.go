@@ -1,9 +1,26 @@
 package arraystack
 
-import "github.com/Arafatk/Dataviz/containers"
+import (
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"iter"
+
+	"github.com/Arafatk/Dataviz/lists/arraylist"
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/binarycodec"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
 
 var _ containers.JSONSerializer = (*Stack)(nil)
 var _ containers.JSONDeserializer = (*Stack)(nil)
+var _ containers.BinarySerializer = (*Stack)(nil)
+var _ containers.BinaryDeserializer = (*Stack)(nil)
+var _ gob.GobEncoder = (*Stack)(nil)
+var _ gob.GobDecoder = (*Stack)(nil)
+var _ json.Marshaler = (*Stack)(nil)
+var _ json.Unmarshaler = (*Stack)(nil)
 
 // ToJSON outputs the JSON representation of list's elements.
 func (stack *Stack) ToJSON() ([]byte, error) {
@@ -14,3 +31,66 @@ func (stack *Stack) ToJSON() ([]byte, error) {
 func (stack *Stack) FromJSON(data []byte) error {
 	return stack.list.FromJSON(data)
 }
+
+// MarshalJSON outputs the JSON representation of the stack's elements for encoding/json, same as ToJSON.
+func (stack *Stack) MarshalJSON() ([]byte, error) {
+	return stack.ToJSON()
+}
+
+// UnmarshalJSON populates the stack's elements from the input JSON representation for encoding/json, same as FromJSON.
+// A zero Stack, e.g. one allocated by encoding/json for a nil *Stack field, is initialized before decoding into it.
+func (stack *Stack) UnmarshalJSON(data []byte) error {
+	if stack.list == nil {
+		stack.list = arraylist.New()
+	}
+	return stack.FromJSON(data)
+}
+
+// WriteJSON writes the JSON representation of the stack's elements to the writer, same as ToJSON.
+// The elements are written one at a time while iterating over the stack, see the jsonstream package.
+func (stack *Stack) WriteJSON(w io.Writer) error {
+	return jsonstream.WriteArray(w, stack.bottomUp())
+}
+
+// ReadJSON populates the stack's elements from the JSON representation read from the reader, same as FromJSON.
+func (stack *Stack) ReadJSON(r io.Reader) error {
+	values, err := jsonstream.ReadArray[any](r)
+	if err != nil {
+		return err
+	}
+	stack.list.Clear()
+	stack.list.Add(values...)
+	return nil
+}
+
+// MarshalBinary outputs the compact binary representation of the stack's elements from the bottom to the top, see the binarycodec package.
+func (stack *Stack) MarshalBinary() ([]byte, error) {
+	return binarycodec.Marshal(stack.bottomUp())
+}
+
+// UnmarshalBinary populates the stack's elements from the input binary representation.
+func (stack *Stack) UnmarshalBinary(data []byte) error {
+	values, err := binarycodec.Unmarshal[any](data)
+	if err != nil {
+		return err
+	}
+	stack.list.Clear()
+	stack.list.Add(values...)
+	return nil
+}
+
+// GobEncode outputs the binary representation of the stack's elements for encoding/gob, same as MarshalBinary.
+func (stack *Stack) GobEncode() ([]byte, error) {
+	return stack.MarshalBinary()
+}
+
+// GobDecode populates the stack's elements from the binary representation for encoding/gob, same as UnmarshalBinary.
+func (stack *Stack) GobDecode(data []byte) error {
+	return stack.UnmarshalBinary(data)
+}
+
+// bottomUp returns a sequence of the stack's elements from the bottom to the top, i.e. in the order of its underlying list.
+func (stack *Stack) bottomUp() iter.Seq[any] {
+	it := stack.list.Iterator()
+	return seq.Values(seq.WithIndex(&it))
+}
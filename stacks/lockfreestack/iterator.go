@@ -0,0 +1,66 @@
+package lockfreestack
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.IteratorWithIndex = (*Iterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	stack *Stack
+	top   *node
+	node  *node
+	index int
+}
+
+// Iterator returns a stateful iterator over a snapshot of the stack, whose values can be fetched by an index.
+// The snapshot is taken when the iterator is created and by Begin and First.
+func (stack *Stack) Iterator() Iterator {
+	return Iterator{stack: stack, top: stack.load(), index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	switch {
+	case iterator.index == -1:
+		iterator.node = iterator.top
+	case iterator.node != nil:
+		iterator.node = iterator.node.next
+	}
+	if iterator.node == nil {
+		iterator.index = -2
+		return false
+	}
+	iterator.index++
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() any {
+	return iterator.node.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first) on a new snapshot of the stack.
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.top = iterator.stack.load()
+	iterator.node = nil
+	iterator.index = -1
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
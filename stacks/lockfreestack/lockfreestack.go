@@ -0,0 +1,117 @@
+// Package lockfreestack implements a lock-free stack (Treiber stack).
+//
+// Structure is thread safe. Push and Pop never block, they retry a compare-and-swap of the top of the stack instead.
+// The nodes of the stack are never modified once pushed, so Values, String and iterators work on a consistent
+// snapshot of the stack taken when they start. Size is maintained separately and may lag behind concurrent operations.
+//
+// Reference: https://en.wikipedia.org/wiki/Treiber_stack
+package lockfreestack
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+
+	"github.com/Arafatk/Dataviz/stacks"
+)
+
+var _ stacks.Stack = (*Stack)(nil)
+
+// Stack holds elements in a singly-linked list of immutable nodes
+type Stack struct {
+	top  atomic.Pointer[node]
+	size atomic.Int64
+}
+
+type node struct {
+	value any
+	next  *node
+}
+
+// New instantiates a new empty stack
+func New() *Stack {
+	return &Stack{}
+}
+
+// Push adds a value onto the top of the stack
+func (stack *Stack) Push(value any) {
+	n := &node{value: value}
+	for {
+		top := stack.top.Load()
+		n.next = top
+		if stack.top.CompareAndSwap(top, n) {
+			stack.size.Add(1)
+			return
+		}
+	}
+}
+
+// Pop removes top element on stack and returns it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack) Pop() (value any, ok bool) {
+	for {
+		top := stack.top.Load()
+		if top == nil {
+			return nil, false
+		}
+		if stack.top.CompareAndSwap(top, top.next) {
+			stack.size.Add(-1)
+			return top.value, true
+		}
+	}
+}
+
+// Peek returns top element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack) Peek() (value any, ok bool) {
+	if top := stack.load(); top != nil {
+		return top.value, true
+	}
+	return nil, false
+}
+
+// Empty returns true if stack does not contain any elements.
+func (stack *Stack) Empty() bool {
+	return stack.load() == nil
+}
+
+// Size returns number of elements within the stack.
+func (stack *Stack) Size() int {
+	if size := stack.size.Load(); size > 0 {
+		return int(size)
+	}
+	return 0
+}
+
+// Clear removes all elements from the stack.
+func (stack *Stack) Clear() {
+	removed := int64(0)
+	for n := stack.top.Swap(nil); n != nil; n = n.next {
+		removed++
+	}
+	stack.size.Add(-removed)
+}
+
+// Values returns all elements in the stack (LIFO order).
+func (stack *Stack) Values() []any {
+	values := []any{}
+	for n := stack.load(); n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (stack *Stack) String() string {
+	str := "LockFreeStack\n"
+	values := []string{}
+	for _, value := range stack.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+func (stack *Stack) load() *node {
+	return stack.top.Load()
+}
@@ -0,0 +1,280 @@
+package lockfreestack
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/stacks/arraystack"
+	"github.com/riadafridishibly/DataViz/synchronized"
+)
+
+func TestStackPush(t *testing.T) {
+	stack := New()
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+
+	if actualValue, expectedValue := fmt.Sprint(stack.Values()), "[3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := stack.Empty(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := stack.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := stack.Peek(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestStackPop(t *testing.T) {
+	stack := New()
+	stack.Push(1)
+	stack.Push(2)
+	stack.Push(3)
+	stack.Pop()
+	if actualValue, ok := stack.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := stack.Pop(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := stack.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := stack.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestStackClear(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Clear()
+	if actualValue := stack.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, expectedValue := stack.String(), "LockFreeStack\n"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+	stack.Push("c")
+	if actualValue, expectedValue := stack.String(), "LockFreeStack\nc"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+}
+
+func TestStackIterator(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+
+	it := stack.Iterator()
+	stack.Pop()
+	stack.Push("d")
+	count := 0
+	for it.Next() {
+		count++
+		index, value := it.Index(), it.Value()
+		switch index {
+		case 0:
+			if actualValue, expectedValue := value, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 1:
+			if actualValue, expectedValue := value, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := value, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Next() {
+		t.Errorf("Expected no element after the last one")
+	}
+
+	// Begin takes a new snapshot
+	if !it.First() || it.Index() != 0 || it.Value() != "d" {
+		t.Errorf("Got %v:%v expected %v:%v", it.Index(), it.Value(), 0, "d")
+	}
+	if it := New().Iterator(); it.Next() || it.First() {
+		t.Errorf("Shouldn't iterate on empty stack")
+	}
+}
+
+func TestStackConcurrent(t *testing.T) {
+	const workers, iterations = 8, 1000
+	stack := New()
+	var wg sync.WaitGroup
+	popped := make([][]int, workers)
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(2)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				stack.Push(worker*iterations + i)
+			}
+		}(worker)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				if value, ok := stack.Pop(); ok {
+					popped[worker] = append(popped[worker], value.(int))
+				}
+				stack.Peek()
+				stack.Size()
+			}
+		}(worker)
+	}
+	wg.Wait()
+	for value, ok := stack.Pop(); ok; value, ok = stack.Pop() {
+		popped[0] = append(popped[0], value.(int))
+	}
+	seen := make([]bool, workers*iterations)
+	for _, values := range popped {
+		for _, value := range values {
+			if seen[value] {
+				t.Fatalf("Value %v popped twice", value)
+			}
+			seen[value] = true
+		}
+	}
+	for value, ok := range seen {
+		if !ok {
+			t.Fatalf("Value %v was not popped", value)
+		}
+	}
+	if actualValue := stack.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestStackMarshalJSON(t *testing.T) {
+	type document struct {
+		Name  string
+		Stack *Stack
+	}
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	data, err := json.Marshal(document{Name: "letters", Stack: stack})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Stack":["a","b","c"]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	var decoded document
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Stack.Values()), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := decoded.Stack.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestStackSeq(t *testing.T) {
+	stack := New()
+	stack.Push("a")
+	stack.Push("b")
+	stack.Push("c")
+	actual := []string{}
+	for index, value := range stack.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:c 1:b 2:a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(stack.SeqValues())), "[c b a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for index, value := range stack.Backward() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+		if index == 1 {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:a 1:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzStack(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckStack(New(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+// benchmarkContended pushes and pops from all goroutines at once, so that they compete for the top of the stack.
+func benchmarkContended(b *testing.B, stack interface {
+	Push(value any)
+	Pop() (value any, ok bool)
+}) {
+	for n := 0; n < 1000; n++ {
+		stack.Push(n)
+	}
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for i := 0; pb.Next(); i++ {
+			if i%2 == 0 {
+				stack.Push(i)
+			} else {
+				stack.Pop()
+			}
+		}
+	})
+}
+
+func BenchmarkLockFreeStackContended(b *testing.B) {
+	benchmarkContended(b, New())
+}
+
+func BenchmarkArrayStackContended(b *testing.B) {
+	benchmarkContended(b, synchronized.NewStack(arraystack.New()))
+}
+
+func BenchmarkLockFreeStackPush(b *testing.B) {
+	stack := New()
+	for n := 0; n < b.N; n++ {
+		stack.Push(n)
+	}
+}
+
+func BenchmarkArrayStackPush(b *testing.B) {
+	stack := arraystack.New()
+	for n := 0; n < b.N; n++ {
+		stack.Push(n)
+	}
+}
@@ -0,0 +1,38 @@
+package lockfreestack
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the indices and values of the stack from top to bottom, for use with range.
+// The sequence ranges over a snapshot of the stack taken when the ranging starts.
+func (stack *Stack) Seq() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		for it := stack.Iterator(); it.Next(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqValues returns a sequence of the values of the stack from top to bottom, for use with range.
+// The sequence ranges over a snapshot of the stack taken when the ranging starts.
+func (stack *Stack) SeqValues() iter.Seq[any] {
+	return seq.Values(stack.Seq())
+}
+
+// Backward returns a sequence of the indices and values of the stack from bottom to top, for use with range.
+// The sequence ranges over a snapshot of the stack taken when the ranging starts.
+func (stack *Stack) Backward() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		values := stack.Values()
+		for index := len(values) - 1; index >= 0; index-- {
+			if !yield(index, values[index]) {
+				return
+			}
+		}
+	}
+}
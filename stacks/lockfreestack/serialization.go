@@ -0,0 +1,52 @@
+package lockfreestack
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+)
+
+var _ containers.JSONSerializer = (*Stack)(nil)
+var _ containers.JSONDeserializer = (*Stack)(nil)
+var _ json.Marshaler = (*Stack)(nil)
+var _ json.Unmarshaler = (*Stack)(nil)
+
+// ToJSON outputs the JSON representation of the stack's elements from the bottom to the top, like the one of arraystack.
+// The elements are taken from a snapshot of the stack.
+func (stack *Stack) ToJSON() ([]byte, error) {
+	values := stack.Values()
+	slices.Reverse(values)
+	var buffer bytes.Buffer
+	if err := jsonstream.WriteArray(&buffer, slices.Values(values)); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// FromJSON populates the stack's elements from the input JSON representation, pushing them from the bottom to the top.
+// The stack is cleared and refilled element by element, so concurrent operations may interleave with the refill.
+func (stack *Stack) FromJSON(data []byte) error {
+	values, err := jsonstream.ReadArray[any](bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	stack.Clear()
+	for _, value := range values {
+		stack.Push(value)
+	}
+	return nil
+}
+
+// MarshalJSON outputs the JSON representation of the stack's elements for encoding/json, same as ToJSON.
+func (stack *Stack) MarshalJSON() ([]byte, error) {
+	return stack.ToJSON()
+}
+
+// UnmarshalJSON populates the stack's elements from the input JSON representation for encoding/json, same as FromJSON.
+// A zero Stack is an empty stack, so a nil *Stack field of a struct can be decoded into.
+func (stack *Stack) UnmarshalJSON(data []byte) error {
+	return stack.FromJSON(data)
+}
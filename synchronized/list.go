@@ -0,0 +1,119 @@
+package synchronized
+
+import (
+	"sync"
+
+	"github.com/Arafatk/Dataviz/lists"
+	"github.com/Arafatk/Dataviz/utils"
+)
+
+var _ lists.List = (*List)(nil)
+
+// List is a list safe for concurrent use
+type List struct {
+	mutex sync.RWMutex
+	list  lists.List
+}
+
+// NewList wraps the list, which must not be used directly afterwards.
+func NewList(list lists.List) *List {
+	return &List{list: list}
+}
+
+// Get returns the element at index.
+// Second return parameter is true if index is within bounds of the list, otherwise false.
+func (list *List) Get(index int) (any, bool) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return list.list.Get(index)
+}
+
+// Remove removes the element at the given index from the list.
+func (list *List) Remove(index int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Remove(index)
+}
+
+// Add appends values at the end of the list.
+func (list *List) Add(values ...any) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Add(values...)
+}
+
+// Contains checks if all values are present in the list.
+func (list *List) Contains(values ...any) bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return list.list.Contains(values...)
+}
+
+// Sort sorts values in-place using the comparator.
+func (list *List) Sort(comparator utils.Comparator) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Sort(comparator)
+}
+
+// Swap swaps the two values at the specified positions.
+func (list *List) Swap(index1, index2 int) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Swap(index1, index2)
+}
+
+// Insert inserts values at the specified index position, shifting the value at that position (if any) and any subsequent elements to the right.
+func (list *List) Insert(index int, values ...any) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Insert(index, values...)
+}
+
+// Empty returns true if list does not contain any elements.
+func (list *List) Empty() bool {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return list.list.Empty()
+}
+
+// Size returns number of elements within the list.
+func (list *List) Size() int {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return list.list.Size()
+}
+
+// Clear removes all elements from the list.
+func (list *List) Clear() {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	list.list.Clear()
+}
+
+// Values returns a copy of all elements in the list.
+func (list *List) Values() []any {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return append([]any(nil), list.list.Values()...)
+}
+
+// Iterator returns an iterator over a snapshot of the list's elements.
+func (list *List) Iterator() *IteratorWithIndex {
+	return newIteratorWithIndex(list.Values())
+}
+
+// Read calls f with the wrapped list while holding the read lock.
+// The list must not be modified by f.
+func (list *List) Read(f func(list lists.List)) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	f(list.list)
+}
+
+// Write calls f with the wrapped list while holding the write lock, so that f may modify the list atomically.
+func (list *List) Write(f func(list lists.List)) {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	f(list.list)
+}
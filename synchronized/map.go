@@ -0,0 +1,102 @@
+package synchronized
+
+import (
+	"sync"
+
+	"github.com/Arafatk/Dataviz/maps"
+)
+
+var _ maps.Map = (*Map)(nil)
+
+// Map is a map safe for concurrent use.
+//
+// Trees holding key-value pairs, e.g. redblacktree.Tree, avltree.Tree and btree.Tree, implement maps.Map and can be wrapped too.
+type Map struct {
+	mutex sync.RWMutex
+	m     maps.Map
+}
+
+// NewMap wraps the map, which must not be used directly afterwards.
+func NewMap(m maps.Map) *Map {
+	return &Map{m: m}
+}
+
+// Put inserts key-value pair into the map.
+func (m *Map) Put(key any, value any) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.m.Put(key, value)
+}
+
+// Get searches the element in the map by key and returns its value or nil if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map) Get(key any) (value any, found bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.m.Get(key)
+}
+
+// Remove removes the element from the map by key.
+func (m *Map) Remove(key any) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.m.Remove(key)
+}
+
+// Keys returns a copy of all keys of the map.
+func (m *Map) Keys() []any {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return append([]any(nil), m.m.Keys()...)
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map) Empty() bool {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (m *Map) Size() int {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return m.m.Size()
+}
+
+// Clear removes all elements from the map.
+func (m *Map) Clear() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.m.Clear()
+}
+
+// Values returns a copy of all values of the map.
+func (m *Map) Values() []any {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return append([]any(nil), m.m.Values()...)
+}
+
+// Iterator returns an iterator over a snapshot of the map's key-value pairs,
+// taken in the order of the wrapped map's Keys() and Values().
+func (m *Map) Iterator() *IteratorWithKey {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return newIteratorWithKey(append([]any(nil), m.m.Keys()...), append([]any(nil), m.m.Values()...))
+}
+
+// Read calls f with the wrapped map while holding the read lock.
+// The map must not be modified by f.
+func (m *Map) Read(f func(m maps.Map)) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	f(m.m)
+}
+
+// Write calls f with the wrapped map while holding the write lock, so that f may modify the map atomically.
+func (m *Map) Write(f func(m maps.Map)) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	f(m.m)
+}
@@ -0,0 +1,113 @@
+package synchronized
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/riadafridishibly/DataViz/containers"
+)
+
+var _ json.Marshaler = (*List)(nil)
+var _ json.Unmarshaler = (*List)(nil)
+var _ json.Marshaler = (*Map)(nil)
+var _ json.Unmarshaler = (*Map)(nil)
+var _ json.Marshaler = (*Stack)(nil)
+var _ json.Unmarshaler = (*Stack)(nil)
+var _ json.Marshaler = (*Tree)(nil)
+var _ json.Unmarshaler = (*Tree)(nil)
+var _ json.Marshaler = (*Heap)(nil)
+var _ json.Unmarshaler = (*Heap)(nil)
+
+// MarshalJSON outputs the JSON representation of the wrapped list for encoding/json while holding the read lock.
+func (list *List) MarshalJSON() ([]byte, error) {
+	list.mutex.RLock()
+	defer list.mutex.RUnlock()
+	return marshalJSON(list.list)
+}
+
+// UnmarshalJSON populates the wrapped list from the input JSON representation for encoding/json while holding the write lock.
+func (list *List) UnmarshalJSON(data []byte) error {
+	list.mutex.Lock()
+	defer list.mutex.Unlock()
+	return unmarshalJSON(list.list, data)
+}
+
+// MarshalJSON outputs the JSON representation of the wrapped map for encoding/json while holding the read lock.
+func (m *Map) MarshalJSON() ([]byte, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+	return marshalJSON(m.m)
+}
+
+// UnmarshalJSON populates the wrapped map from the input JSON representation for encoding/json while holding the write lock.
+func (m *Map) UnmarshalJSON(data []byte) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return unmarshalJSON(m.m, data)
+}
+
+// MarshalJSON outputs the JSON representation of the wrapped stack for encoding/json while holding the read lock.
+func (stack *Stack) MarshalJSON() ([]byte, error) {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+	return marshalJSON(stack.stack)
+}
+
+// UnmarshalJSON populates the wrapped stack from the input JSON representation for encoding/json while holding the write lock.
+func (stack *Stack) UnmarshalJSON(data []byte) error {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return unmarshalJSON(stack.stack, data)
+}
+
+// MarshalJSON outputs the JSON representation of the wrapped tree for encoding/json while holding the read lock.
+func (tree *Tree) MarshalJSON() ([]byte, error) {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	return marshalJSON(tree.tree)
+}
+
+// UnmarshalJSON populates the wrapped tree from the input JSON representation for encoding/json while holding the write lock.
+func (tree *Tree) UnmarshalJSON(data []byte) error {
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	return unmarshalJSON(tree.tree, data)
+}
+
+// MarshalJSON outputs the JSON representation of the wrapped heap for encoding/json while holding the read lock.
+func (heap *Heap) MarshalJSON() ([]byte, error) {
+	heap.mutex.RLock()
+	defer heap.mutex.RUnlock()
+	return marshalJSON(heap.heap)
+}
+
+// UnmarshalJSON populates the wrapped heap from the input JSON representation for encoding/json while holding the write lock.
+func (heap *Heap) UnmarshalJSON(data []byte) error {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return unmarshalJSON(heap.heap, data)
+}
+
+// marshalJSON returns the JSON representation of the container by its MarshalJSON or, failing that, its ToJSON method.
+// Returns an error if the container implements neither, rather than letting encoding/json output its unexported fields as {}.
+func marshalJSON(container any) ([]byte, error) {
+	switch container := container.(type) {
+	case json.Marshaler:
+		return container.MarshalJSON()
+	case containers.JSONSerializer:
+		return container.ToJSON()
+	}
+	return nil, fmt.Errorf("synchronized: %T does not support JSON serialization", container)
+}
+
+// unmarshalJSON populates the container from the JSON representation by its UnmarshalJSON or, failing that, its FromJSON method.
+// Returns an error if the container implements neither, e.g. if the wrapper is a zero value wrapping no container.
+func unmarshalJSON(container any, data []byte) error {
+	switch container := container.(type) {
+	case json.Unmarshaler:
+		return container.UnmarshalJSON(data)
+	case containers.JSONDeserializer:
+		return container.FromJSON(data)
+	}
+	return fmt.Errorf("synchronized: %T does not support JSON deserialization", container)
+}
@@ -0,0 +1,91 @@
+package synchronized
+
+import (
+	"sync"
+
+	"github.com/Arafatk/Dataviz/stacks"
+)
+
+var _ stacks.Stack = (*Stack)(nil)
+
+// Stack is a stack safe for concurrent use
+type Stack struct {
+	mutex sync.RWMutex
+	stack stacks.Stack
+}
+
+// NewStack wraps the stack, which must not be used directly afterwards.
+func NewStack(stack stacks.Stack) *Stack {
+	return &Stack{stack: stack}
+}
+
+// Push adds a value onto the top of the stack
+func (stack *Stack) Push(value any) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	stack.stack.Push(value)
+}
+
+// Pop removes top element on stack and returns it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to pop.
+func (stack *Stack) Pop() (value any, ok bool) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	return stack.stack.Pop()
+}
+
+// Peek returns top element on the stack without removing it, or nil if stack is empty.
+// Second return parameter is true, unless the stack was empty and there was nothing to peek.
+func (stack *Stack) Peek() (value any, ok bool) {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+	return stack.stack.Peek()
+}
+
+// Empty returns true if stack does not contain any elements.
+func (stack *Stack) Empty() bool {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+	return stack.stack.Empty()
+}
+
+// Size returns number of elements within the stack.
+func (stack *Stack) Size() int {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+	return stack.stack.Size()
+}
+
+// Clear removes all elements from the stack.
+func (stack *Stack) Clear() {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	stack.stack.Clear()
+}
+
+// Values returns a copy of all elements in the stack (LIFO order).
+func (stack *Stack) Values() []any {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+	return append([]any(nil), stack.stack.Values()...)
+}
+
+// Iterator returns an iterator over a snapshot of the stack's elements (LIFO order).
+func (stack *Stack) Iterator() *IteratorWithIndex {
+	return newIteratorWithIndex(stack.Values())
+}
+
+// Read calls f with the wrapped stack while holding the read lock.
+// The stack must not be modified by f.
+func (stack *Stack) Read(f func(stack stacks.Stack)) {
+	stack.mutex.RLock()
+	defer stack.mutex.RUnlock()
+	f(stack.stack)
+}
+
+// Write calls f with the wrapped stack while holding the write lock, so that f may modify the stack atomically.
+func (stack *Stack) Write(f func(stack stacks.Stack)) {
+	stack.mutex.Lock()
+	defer stack.mutex.Unlock()
+	f(stack.stack)
+}
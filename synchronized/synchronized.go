@@ -0,0 +1,159 @@
+// Package synchronized provides wrappers making the containers safe for concurrent use.
+//
+// Every wrapper guards the wrapped container with a read-write mutex: methods reading the container
+// may run concurrently with each other, while methods modifying it run exclusively.
+// Compound operations, e.g. a check followed by a modification, can be run atomically by Read and Write.
+//
+// Iterators returned by the wrappers iterate over a snapshot of the container taken when the iterator is created,
+// so the container may be modified while iterating and the iteration never observes a partial modification.
+//
+// The wrapped container must not be accessed other than through its wrapper.
+package synchronized
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.ReverseIteratorWithIndex = (*IteratorWithIndex)(nil)
+var _ containers.ReverseIteratorWithKey = (*IteratorWithKey)(nil)
+
+// IteratorWithIndex iterates over a snapshot of the values of a container
+type IteratorWithIndex struct {
+	values []any
+	index  int
+}
+
+func newIteratorWithIndex(values []any) *IteratorWithIndex {
+	return &IteratorWithIndex{values: values, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the snapshot.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *IteratorWithIndex) Next() bool {
+	if iterator.index < len(iterator.values) {
+		iterator.index++
+	}
+	return iterator.index < len(iterator.values)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the snapshot.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *IteratorWithIndex) Prev() bool {
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.index >= 0
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *IteratorWithIndex) Value() any {
+	return iterator.values[iterator.index]
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *IteratorWithIndex) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *IteratorWithIndex) Begin() {
+	iterator.index = -1
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *IteratorWithIndex) End() {
+	iterator.index = len(iterator.values)
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the snapshot.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *IteratorWithIndex) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the snapshot.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *IteratorWithIndex) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// IteratorWithKey iterates over a snapshot of the key-value pairs of a map
+type IteratorWithKey struct {
+	keys   []any
+	values []any
+	index  int
+}
+
+func newIteratorWithKey(keys []any, values []any) *IteratorWithKey {
+	return &IteratorWithKey{keys: keys, values: values, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the snapshot.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *IteratorWithKey) Next() bool {
+	if iterator.index < len(iterator.keys) {
+		iterator.index++
+	}
+	return iterator.index < len(iterator.keys)
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the snapshot.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *IteratorWithKey) Prev() bool {
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.index >= 0
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *IteratorWithKey) Value() any {
+	return iterator.values[iterator.index]
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *IteratorWithKey) Key() any {
+	return iterator.keys[iterator.index]
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *IteratorWithKey) Begin() {
+	iterator.index = -1
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *IteratorWithKey) End() {
+	iterator.index = len(iterator.keys)
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the snapshot.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *IteratorWithKey) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the snapshot.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *IteratorWithKey) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
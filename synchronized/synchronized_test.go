@@ -0,0 +1,267 @@
+package synchronized
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/Arafatk/Dataviz/lists"
+	"github.com/Arafatk/Dataviz/lists/arraylist"
+	"github.com/Arafatk/Dataviz/maps"
+	"github.com/Arafatk/Dataviz/stacks"
+	"github.com/Arafatk/Dataviz/trees"
+	"github.com/riadafridishibly/DataViz/maps/treemap"
+	"github.com/riadafridishibly/DataViz/stacks/arraystack"
+	"github.com/riadafridishibly/DataViz/trees/avltree"
+	"github.com/riadafridishibly/DataViz/trees/binaryheap"
+	"github.com/riadafridishibly/DataViz/trees/btree"
+	"github.com/riadafridishibly/DataViz/trees/redblacktree"
+)
+
+const (
+	workers    = 8
+	iterations = 200
+)
+
+// parallel runs f on several goroutines at once and waits for all of them.
+func parallel(f func(worker int)) {
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			f(worker)
+		}(worker)
+	}
+	wg.Wait()
+}
+
+func TestListConcurrent(t *testing.T) {
+	list := NewList(arraylist.New())
+	parallel(func(worker int) {
+		for i := 0; i < iterations; i++ {
+			list.Add(worker*iterations + i)
+			list.Get(i)
+			list.Contains(i)
+			list.Size()
+			for it := list.Iterator(); it.Next(); {
+				_ = it.Value()
+			}
+		}
+	})
+	if actualValue, expectedValue := list.Size(), workers*iterations; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	parallel(func(worker int) {
+		for i := 0; i < iterations; i++ {
+			list.Remove(0)
+		}
+	})
+	if actualValue := list.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMapConcurrent(t *testing.T) {
+	for _, m := range []maps.Map{
+		treemap.NewWithIntComparator(),
+		redblacktree.NewWithIntComparator(),
+		avltree.NewWithIntComparator(),
+		btree.NewWithIntComparator(3),
+	} {
+		sm := NewMap(m)
+		parallel(func(worker int) {
+			for i := 0; i < iterations; i++ {
+				key := worker*iterations + i
+				sm.Put(key, i)
+				if value, found := sm.Get(key); !found || value != i {
+					t.Errorf("Got %v, %v expected %v, true", value, found, i)
+				}
+				if i%2 == 0 {
+					sm.Remove(key)
+				}
+				sm.Keys()
+			}
+		})
+		if actualValue, expectedValue := sm.Size(), workers*iterations/2; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v for %T", actualValue, expectedValue, m)
+		}
+	}
+}
+
+func TestMapWriteIsAtomic(t *testing.T) {
+	m := NewMap(treemap.NewWithStringComparator())
+	parallel(func(worker int) {
+		for i := 0; i < iterations; i++ {
+			m.Write(func(m maps.Map) {
+				count, _ := m.Get("count")
+				if count == nil {
+					count = 0
+				}
+				m.Put("count", count.(int)+1)
+			})
+		}
+	})
+	if actualValue, _ := m.Get("count"); actualValue != workers*iterations {
+		t.Errorf("Got %v expected %v", actualValue, workers*iterations)
+	}
+	m.Read(func(m maps.Map) {
+		if actualValue := m.Size(); actualValue != 1 {
+			t.Errorf("Got %v expected %v", actualValue, 1)
+		}
+	})
+}
+
+func TestMapIteratorSnapshot(t *testing.T) {
+	m := NewMap(treemap.NewWithIntComparator())
+	for i := 0; i < 10; i++ {
+		m.Put(i, fmt.Sprint(i))
+	}
+	it := m.Iterator()
+	m.Clear()
+	count := 0
+	for it.Next() {
+		if it.Key() != count || it.Value() != fmt.Sprint(count) {
+			t.Errorf("Got %v:%v expected %v:%v", it.Key(), it.Value(), count, count)
+		}
+		count++
+	}
+	if count != 10 {
+		t.Errorf("Got %v expected %v", count, 10)
+	}
+	if !it.Last() || it.Key() != 9 || it.Prev() != true || it.Key() != 8 {
+		t.Errorf("Got %v expected %v", it.Key(), 8)
+	}
+	if it.First(); it.Prev() {
+		t.Errorf("Expected no element before the first one")
+	}
+
+	// modifying the map while iterating does not deadlock
+	m.Put(1, "a")
+	m.Put(2, "b")
+	for it := m.Iterator(); it.Next(); {
+		m.Remove(it.Key())
+	}
+	if !m.Empty() {
+		t.Errorf("Got %v expected an empty map", m.Keys())
+	}
+}
+
+func TestStackConcurrent(t *testing.T) {
+	stack := NewStack(arraystack.New())
+	parallel(func(worker int) {
+		for i := 0; i < iterations; i++ {
+			stack.Push(i)
+			stack.Peek()
+			stack.Values()
+		}
+	})
+	popped := make([]int, workers)
+	parallel(func(worker int) {
+		for {
+			if _, ok := stack.Pop(); !ok {
+				return
+			}
+			popped[worker]++
+		}
+	})
+	total := 0
+	for _, count := range popped {
+		total += count
+	}
+	if total != workers*iterations {
+		t.Errorf("Got %v expected %v", total, workers*iterations)
+	}
+	stack.Write(func(stack stacks.Stack) {
+		stack.Push(1)
+		stack.Push(2)
+	})
+	if actualValue, expectedValue := fmt.Sprint(stack.Iterator().values), "[2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestHeapConcurrent(t *testing.T) {
+	heap := NewHeap(binaryheap.NewWithIntComparator())
+	parallel(func(worker int) {
+		for i := 0; i < iterations; i++ {
+			heap.Push(i, iterations-i)
+			heap.Peek()
+		}
+	})
+	prev := -1
+	for !heap.Empty() {
+		value, _ := heap.Pop()
+		if value.(int) < prev {
+			t.Errorf("Heap order violated: %v after %v", value, prev)
+		}
+		prev = value.(int)
+	}
+}
+
+func TestTreeConcurrent(t *testing.T) {
+	tree := NewTree(redblacktree.NewWithIntComparator())
+	parallel(func(worker int) {
+		for i := 0; i < iterations; i++ {
+			key := worker*iterations + i
+			tree.Write(func(tree trees.Tree) {
+				tree.(*redblacktree.Tree).Put(key, key)
+			})
+			tree.Read(func(tree trees.Tree) {
+				tree.(*redblacktree.Tree).Get(key)
+			})
+			tree.Size()
+		}
+	})
+	values := tree.Values()
+	if len(values) != workers*iterations {
+		t.Errorf("Got %v expected %v", len(values), workers*iterations)
+	}
+	for i, value := range values {
+		if value != i {
+			t.Fatalf("Got %v expected %v", value, i)
+		}
+	}
+}
+
+func TestListRead(t *testing.T) {
+	list := NewList(arraylist.New())
+	list.Add("a", "b")
+	list.Read(func(list lists.List) {
+		if actualValue, expectedValue := fmt.Sprint(list.Values()), "[a b]"; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	})
+}
+
+func TestMarshalJSON(t *testing.T) {
+	type document struct {
+		List  *List
+		Map   *Map
+		Stack *Stack
+	}
+	m := treemap.NewWithStringComparator()
+	m.Put("a", 1)
+	doc := document{List: NewList(arraylist.New()), Map: NewMap(m), Stack: NewStack(arraystack.New())}
+	doc.List.Add("x", "y")
+	doc.Stack.Push(1)
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"List":["x","y"],"Map":{"a":1},"Stack":[1]}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	decoded := document{List: NewList(arraylist.New()), Map: NewMap(treemap.NewWithStringComparator()), Stack: NewStack(arraystack.New())}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.List.Values(), decoded.Map.Keys(), decoded.Stack.Values()), "[x y] [a] [1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for wrappers without a container")
+	}
+}
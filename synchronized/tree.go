@@ -0,0 +1,164 @@
+package synchronized
+
+import (
+	"sync"
+
+	"github.com/Arafatk/Dataviz/containers"
+	"github.com/Arafatk/Dataviz/trees"
+)
+
+var _ trees.Tree = (*Tree)(nil)
+var _ trees.Tree = (*Heap)(nil)
+
+// Tree is a tree safe for concurrent use.
+//
+// Operations specific to the wrapped tree are run by Read and Write.
+// Trees holding key-value pairs implement maps.Map and are better wrapped by NewMap, heaps are better wrapped by NewHeap.
+type Tree struct {
+	mutex sync.RWMutex
+	tree  trees.Tree
+}
+
+// NewTree wraps the tree, which must not be used directly afterwards.
+func NewTree(tree trees.Tree) *Tree {
+	return &Tree{tree: tree}
+}
+
+// Empty returns true if tree does not contain any nodes.
+func (tree *Tree) Empty() bool {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	return tree.tree.Empty()
+}
+
+// Size returns number of nodes in the tree.
+func (tree *Tree) Size() int {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	return tree.tree.Size()
+}
+
+// Clear removes all nodes from the tree.
+func (tree *Tree) Clear() {
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	tree.tree.Clear()
+}
+
+// Values returns a copy of all values of the tree.
+func (tree *Tree) Values() []any {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	return append([]any(nil), tree.tree.Values()...)
+}
+
+// Iterator returns an iterator over a snapshot of the tree's values.
+func (tree *Tree) Iterator() *IteratorWithIndex {
+	return newIteratorWithIndex(tree.Values())
+}
+
+// Read calls f with the wrapped tree while holding the read lock.
+// The tree must not be modified by f.
+func (tree *Tree) Read(f func(tree trees.Tree)) {
+	tree.mutex.RLock()
+	defer tree.mutex.RUnlock()
+	f(tree.tree)
+}
+
+// Write calls f with the wrapped tree while holding the write lock, so that f may modify the tree atomically.
+func (tree *Tree) Write(f func(tree trees.Tree)) {
+	tree.mutex.Lock()
+	defer tree.mutex.Unlock()
+	f(tree.tree)
+}
+
+// PriorityQueue is the interface implemented by heaps, e.g. binaryheap.Heap.
+type PriorityQueue interface {
+	Push(values ...any)
+	Pop() (value any, ok bool)
+	Peek() (value any, ok bool)
+
+	containers.Container
+}
+
+// Heap is a heap safe for concurrent use
+type Heap struct {
+	mutex sync.RWMutex
+	heap  PriorityQueue
+}
+
+// NewHeap wraps the heap, which must not be used directly afterwards.
+func NewHeap(heap PriorityQueue) *Heap {
+	return &Heap{heap: heap}
+}
+
+// Push adds values onto the heap.
+func (heap *Heap) Push(values ...any) {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	heap.heap.Push(values...)
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap) Pop() (value any, ok bool) {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	return heap.heap.Pop()
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap) Peek() (value any, ok bool) {
+	heap.mutex.RLock()
+	defer heap.mutex.RUnlock()
+	return heap.heap.Peek()
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap) Empty() bool {
+	heap.mutex.RLock()
+	defer heap.mutex.RUnlock()
+	return heap.heap.Empty()
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap) Size() int {
+	heap.mutex.RLock()
+	defer heap.mutex.RUnlock()
+	return heap.heap.Size()
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap) Clear() {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	heap.heap.Clear()
+}
+
+// Values returns a copy of all elements in the heap.
+func (heap *Heap) Values() []any {
+	heap.mutex.RLock()
+	defer heap.mutex.RUnlock()
+	return append([]any(nil), heap.heap.Values()...)
+}
+
+// Iterator returns an iterator over a snapshot of the heap's elements.
+func (heap *Heap) Iterator() *IteratorWithIndex {
+	return newIteratorWithIndex(heap.Values())
+}
+
+// Read calls f with the wrapped heap while holding the read lock.
+// The heap must not be modified by f.
+func (heap *Heap) Read(f func(heap PriorityQueue)) {
+	heap.mutex.RLock()
+	defer heap.mutex.RUnlock()
+	f(heap.heap)
+}
+
+// Write calls f with the wrapped heap while holding the write lock, so that f may modify the heap atomically.
+func (heap *Heap) Write(f func(heap PriorityQueue)) {
+	heap.mutex.Lock()
+	defer heap.mutex.Unlock()
+	f(heap.heap)
+}
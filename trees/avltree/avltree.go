@@ -6,20 +6,23 @@
 package avltree
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/Arafatk/Dataviz/trees"
 	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 var _ trees.Tree = new(Tree)
 
 // Tree holds elements of the AVL tree.
 type Tree struct {
-	Root       *Node            // Root node
-	Comparator utils.Comparator // Key comparator
-	size       int              // Total number of keys in the tree
+	Root       *Node             // Root node
+	Comparator utils.Comparator  // Key comparator
+	KeyCodec   keycodec.KeyCodec // Key codec of the JSON representation, nil for a JSON object with string keys
+	size       int               // Total number of keys in the tree
 }
 
 // Node is a single element within the tree
@@ -29,6 +32,7 @@ type Node struct {
 	Parent   *Node    // Parent node
 	Children [2]*Node // Children nodes
 	b        int8
+	size     int // Number of nodes within the subtree rooted at the node
 }
 
 // NewWith instantiates an AVL tree with the custom comparator.
@@ -183,6 +187,25 @@ func (t *Tree) Clear() {
 	t.size = 0
 }
 
+// FromSorted replaces the contents of the tree with the given key-value pairs.
+// Keys must be sorted in strictly ascending order with respect to the tree's comparator and
+// there must be as many values as keys, otherwise an error is returned and the tree is left unchanged.
+//
+// The tree is built balanced in O(n) time instead of the O(n log n) required by repeated calls to Put.
+func (t *Tree) FromSorted(keys []any, values []any) error {
+	if len(keys) != len(values) {
+		return errors.New("avltree: number of keys and values differ")
+	}
+	for i := 1; i < len(keys); i++ {
+		if t.Comparator(keys[i-1], keys[i]) >= 0 {
+			return errors.New("avltree: keys are not sorted in strictly ascending order")
+		}
+	}
+	t.Root, _ = buildSorted(keys, values, nil)
+	t.size = len(keys)
+	return nil
+}
+
 // String returns a string representation of container
 func (t *Tree) String() string {
 	str := "AVLTree\n"
@@ -249,11 +272,30 @@ func visHelperMap(node *Node, KeyChildLeft *map[int]int, KeyChildRight *map[int]
 	}
 }
 
+// buildSorted builds a balanced subtree from sorted keys by taking the middle key as its root
+// and returns it together with its height.
+func buildSorted(keys []any, values []any, p *Node) (*Node, int) {
+	if len(keys) == 0 {
+		return nil, 0
+	}
+	m := len(keys) / 2
+	n := &Node{Key: keys[m], Value: values[m], Parent: p}
+	var lh, rh int
+	n.Children[0], lh = buildSorted(keys[:m], values[:m], n)
+	n.Children[1], rh = buildSorted(keys[m+1:], values[m+1:], n)
+	n.b = int8(rh - lh)
+	n.size = len(keys)
+	if lh > rh {
+		return n, lh + 1
+	}
+	return n, rh + 1
+}
+
 func (t *Tree) put(key any, value any, p *Node, qp **Node) bool {
 	q := *qp
 	if q == nil {
 		t.size++
-		*qp = &Node{Key: key, Value: value, Parent: p}
+		*qp = &Node{Key: key, Value: value, Parent: p, size: 1}
 		return true
 	}
 
@@ -271,7 +313,11 @@ func (t *Tree) put(key any, value any, p *Node, qp **Node) bool {
 	}
 	a := (c + 1) / 2
 	var fix bool
+	size := t.size
 	fix = t.put(key, value, q, &q.Children[a])
+	if t.size != size {
+		q.size++
+	}
 	if fix {
 		return putFix(int8(c), qp)
 	}
@@ -294,6 +340,7 @@ func (t *Tree) remove(key any, qp **Node) bool {
 			*qp = q.Children[0]
 			return true
 		}
+		q.size--
 		fix := removeMin(&q.Children[1], &q.Key, &q.Value)
 		if fix {
 			return removeFix(-1, qp)
@@ -307,7 +354,11 @@ func (t *Tree) remove(key any, qp **Node) bool {
 		c = 1
 	}
 	a := (c + 1) / 2
+	size := t.size
 	fix := t.remove(key, &q.Children[a])
+	if t.size != size {
+		q.size--
+	}
 	if fix {
 		return removeFix(int8(-c), qp)
 	}
@@ -325,6 +376,7 @@ func removeMin(qp **Node, minKey *any, minVal *any) bool {
 		*qp = q.Children[1]
 		return true
 	}
+	q.size--
 	fix := removeMin(&q.Children[0], minKey, minVal)
 	if fix {
 		return removeFix(1, qp)
@@ -421,9 +473,24 @@ func rotate(c int8, s *Node) *Node {
 	r.Children[a^1] = s
 	r.Parent = s.Parent
 	s.Parent = r
+	s.updateSize()
+	r.updateSize()
 	return r
 }
 
+// updateSize recomputes the size of the subtree rooted at n from the sizes of its children.
+func (n *Node) updateSize() {
+	n.size = 1 + nodeSize(n.Children[0]) + nodeSize(n.Children[1])
+}
+
+// nodeSize returns the number of nodes within the subtree rooted at n.
+func nodeSize(n *Node) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
 func (t *Tree) bottom(d int) *Node {
 	n := t.Root
 	if n == nil {
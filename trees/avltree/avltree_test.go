@@ -1,8 +1,17 @@
 package avltree
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 func TestAVLTreePut(t *testing.T) {
@@ -584,6 +593,707 @@ func TestAVLTreeSerialization(t *testing.T) {
 	assert()
 }
 
+func TestAVLTreeFromSorted(t *testing.T) {
+	for size := 0; size <= 70; size++ {
+		keys := make([]any, size)
+		values := make([]any, size)
+		for i := range keys {
+			keys[i], values[i] = i, fmt.Sprintf("v%d", i)
+		}
+		tree := NewWithIntComparator()
+		tree.Put(-1, "replaced")
+		if err := tree.FromSorted(keys, values); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		assertAVLTree(t, tree, size)
+		if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		tree.Put(size, "x")
+		tree.Remove(0)
+		assertAVLTree(t, tree, size)
+	}
+
+	tree := NewWithIntComparator()
+	if err := tree.FromSorted([]any{1, 3, 2}, []any{1, 3, 2}); err == nil {
+		t.Errorf("Expected error for unsorted keys")
+	}
+	if err := tree.FromSorted([]any{1, 1}, []any{1, 1}); err == nil {
+		t.Errorf("Expected error for duplicate keys")
+	}
+	if err := tree.FromSorted([]any{1, 2}, []any{1}); err == nil {
+		t.Errorf("Expected error for mismatched values")
+	}
+}
+
+func TestAVLTreeFromJSONSorted(t *testing.T) {
+	tree := NewWithStringComparator()
+	for i := 0; i < 100; i++ {
+		tree.Put(fmt.Sprintf("k%03d", i), i)
+	}
+	json, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithStringComparator()
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertAVLTree(t, loaded, 100)
+	if actualValue, expectedValue := fmt.Sprintf("%v", loaded.Keys()), fmt.Sprintf("%v", tree.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.FromJSON([]byte(`{"b":1,"a":2,"b":3}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[a b][2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.FromJSON([]byte(`[1,2]`)); err == nil {
+		t.Errorf("Expected error for non-object input")
+	}
+}
+
+func TestAVLTreeKeyCodec(t *testing.T) {
+	tree := NewWithIntComparator()
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+
+	tree.KeyCodec = keycodec.JSON[int]{}
+	json, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `[{"key":1,"value":"a"},{"key":2,"value":"b"},{"key":3,"value":"c"}]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := NewWithIntComparator()
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[1 2 3][a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// typed keys are restored without a codec
+	tree.KeyCodec = keycodec.Typed
+	if json, err = tree.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded = NewWithIntComparator()
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := loaded.Get(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// unordered pairs, later keys replacing earlier ones
+	if err := loaded.FromJSON([]byte(`[{"key":{"type":"int","value":2},"value":"x"},{"key":{"type":"int","value":1},"value":"y"},{"key":{"type":"int","value":2},"value":"z"}]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[1 2][y z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if err := loaded.FromJSON([]byte(`[{"value":1}]`)); err == nil {
+		t.Errorf("Expected error for a pair without a key")
+	}
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.FromJSON([]byte(`[{"key":"a","value":1}]`)); err == nil {
+		t.Errorf("Expected error for a key of the wrong type")
+	}
+
+	// without a codec the keys are restored as strings, which the IntComparator cannot compare
+	tree.KeyCodec = nil
+	if json, err = tree.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded = NewWithIntComparator()
+	loaded.Put(5, "e")
+	if err := loaded.FromJSON(json); err == nil {
+		t.Errorf("Expected error for string keys of a tree with the IntComparator")
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[5][e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded = NewWithIntComparator()
+	if err := loaded.FromJSON([]byte(`{"1":"a"}`)); err == nil || !loaded.Empty() {
+		t.Errorf("Expected error for a string key of a tree with the IntComparator")
+	}
+}
+
+func TestAVLTreeBinary(t *testing.T) {
+	tree := NewWithIntComparator()
+	for _, i := range rand.Perm(100) {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator()
+	loaded.Put(-1, "replaced")
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Keys(), loaded.Values()), fmt.Sprint(tree.Keys(), tree.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Expected error for truncated data")
+	}
+	if err := (&Tree{}).UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for a tree without a comparator")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(tree); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := NewWithIntComparator()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Keys()), fmt.Sprint(tree.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestAVLTreeWriteReadJSON(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 12; i >= 8; i-- {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	var buffer bytes.Buffer
+	if err := tree.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := buffer.String(), `{"8":"8","9":"9","10":"10","11":"11","12":"12"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	json, err := tree.ToJSON()
+	if actualValue, expectedValue := string(json), buffer.String(); actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.KeyCodec = keycodec.JSON[int]{}
+	buffer.Reset()
+	if err := tree.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator()
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Keys(), loaded.Values()), fmt.Sprint(tree.Keys(), tree.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.ReadJSON(strings.NewReader(`{"a":1`)); err == nil {
+		t.Errorf("Expected error for truncated input")
+	}
+}
+
+func TestAVLTreeMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		Tree *Tree
+	}
+	tree := NewWithStringComparator()
+	tree.Put("c", 3.0)
+	tree.Put("a", 1.0)
+	tree.Put("b", 2.0)
+	data, err := json.Marshal(document{Name: "letters", Tree: tree})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Tree":{"a":1,"b":2,"c":3}}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	decoded := document{Tree: NewWithStringComparator()}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := decoded.Name, "letters"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Tree.Keys(), decoded.Tree.Values()), "[a b c] [1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a tree without a comparator")
+	}
+}
+
+func TestAVLTreeShapeJSON(t *testing.T) {
+	tree := NewWithIntComparator()
+	for _, i := range rand.Perm(200) {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	for i := 0; i < 200; i += 3 {
+		tree.Remove(i)
+	}
+	shape, err := tree.ToShapeJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	loaded := NewWithIntComparator()
+	loaded.Put(-1, "replaced")
+	if err := loaded.FromShapeJSON(shape); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := NewWithStringComparator().FromShapeJSON(shape); err == nil {
+		t.Errorf("Expected error for int keys compared by the StringComparator")
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := loaded.String(), tree.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	reshaped, err := loaded.ToShapeJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(reshaped), string(shape); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded.Put(1000, "x")
+	loaded.Remove(1)
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	empty := NewWithIntComparator()
+	if shape, err = empty.ToShapeJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.FromShapeJSON(shape); err != nil || loaded.Size() != 0 {
+		t.Errorf("Got %v,%v expected an empty tree", loaded.Size(), err)
+	}
+
+	loaded.Put(1, "a")
+	for _, input := range []string{`[]`, `{"root"`, `{"key":{"type":"int","value":1},"value":"a","balance":1}}:{"root":{"key":{"type":"int","value":2},"value":"a","balance":0,"left":{"key":{"type":"int","value":3},"value":"b","balance":0}}}`} {
+		if err := loaded.FromShapeJSON([]byte(input)); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+	if actualValue, found := loaded.Get(1); actualValue != "a" || !found || loaded.Size() != 1 {
+		t.Errorf("Got %v expected the tree to be unchanged", loaded)
+	}
+}
+
+func TestAVLTreeSplit(t *testing.T) {
+	for _, size := range []int{0, 1, 2, 10, 100, 1000} {
+		for _, key := range []int{-1, 0, 1, size / 3, size / 2, size - 1, size, size + 1} {
+			tree := NewWithIntComparator()
+			for _, i := range rand.Perm(size) {
+				tree.Put(i, i*i)
+			}
+			left, right := tree.Split(key)
+			leftSize := key
+			if leftSize < 0 {
+				leftSize = 0
+			}
+			if leftSize > size {
+				leftSize = size
+			}
+			assertAVLTree(t, left, leftSize)
+			assertAVLTree(t, right, size-leftSize)
+			assertAVLTree(t, tree, 0)
+			for i, k := range left.Keys() {
+				if k != i {
+					t.Errorf("Got %v expected %v for left key at %v", k, i, i)
+				}
+			}
+			for i, k := range right.Keys() {
+				if k != leftSize+i {
+					t.Errorf("Got %v expected %v for right key at %v", k, leftSize+i, i)
+				}
+			}
+			if value, found := right.Get(leftSize); leftSize < size && (!found || value != leftSize*leftSize) {
+				t.Errorf("Got %v, %v expected %v, true", value, found, leftSize*leftSize)
+			}
+		}
+	}
+}
+
+func TestAVLTreeJoin(t *testing.T) {
+	for _, leftSize := range []int{0, 1, 2, 7, 100, 1000} {
+		for _, rightSize := range []int{0, 1, 3, 50, 1000} {
+			left, right := NewWithIntComparator(), NewWithIntComparator()
+			for i := 0; i < leftSize; i++ {
+				left.Put(i, i)
+			}
+			for i := 0; i < rightSize; i++ {
+				right.Put(leftSize+i, leftSize+i)
+			}
+			if err := left.Join(right); err != nil {
+				t.Errorf("Got error %v", err)
+			}
+			assertAVLTree(t, left, leftSize+rightSize)
+			assertAVLTree(t, right, 0)
+			for i, k := range left.Keys() {
+				if k != i {
+					t.Errorf("Got %v expected %v for key at %v", k, i, i)
+				}
+			}
+		}
+	}
+
+	tree, other := NewWithIntComparator(), NewWithIntComparator()
+	tree.Put(1, "a")
+	tree.Put(5, "b")
+	other.Put(5, "c")
+	other.Put(6, "d")
+	if err := tree.Join(other); err == nil {
+		t.Errorf("Expected an error joining overlapping trees")
+	}
+	assertAVLTree(t, tree, 2)
+	assertAVLTree(t, other, 2)
+
+	// split and join back again
+	tree = NewWithIntComparator()
+	tree.KeyCodec = keycodec.JSON[int]{}
+	for i := 0; i < 500; i++ {
+		tree.Put(i, i)
+	}
+	left, right := tree.Split(123)
+	if left.KeyCodec != tree.KeyCodec || right.KeyCodec != tree.KeyCodec {
+		t.Errorf("Got %v and %v expected %v", left.KeyCodec, right.KeyCodec, tree.KeyCodec)
+	}
+	left.KeyCodec = nil
+	if err := left.Join(right); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertAVLTree(t, left, 500)
+	if left.KeyCodec != tree.KeyCodec {
+		t.Errorf("Got %v expected %v", left.KeyCodec, tree.KeyCodec)
+	}
+}
+
+func TestPersistentAVLTree(t *testing.T) {
+	versions := []*PersistentTree{NewPersistentWithIntComparator()}
+	models := []map[int]int{{}}
+	for i := 0; i < 2000; i++ {
+		tree, model := versions[len(versions)-1], map[int]int{}
+		for k, v := range models[len(models)-1] {
+			model[k] = v
+		}
+		key := rand.Intn(300)
+		if rand.Intn(3) == 0 {
+			tree = tree.Remove(key)
+			delete(model, key)
+		} else {
+			tree = tree.Put(key, i)
+			model[key] = i
+		}
+		versions, models = append(versions, tree), append(models, model)
+	}
+	for i, tree := range versions {
+		assertPersistentTree(t, tree, len(models[i]))
+		for k, v := range models[i] {
+			if actualValue, found := tree.Get(k); !found || actualValue != v {
+				t.Fatalf("Got %v, %v expected %v, true for key %v in version %v", actualValue, found, v, k, i)
+			}
+		}
+	}
+}
+
+func TestPersistentAVLTreeSharing(t *testing.T) {
+	tree := NewPersistentWithIntComparator()
+	for i := 0; i < 1000; i++ {
+		tree = tree.Put(i, i)
+	}
+	shared := func(older, newer *PersistentTree) int {
+		olderNodes, count := older.nodes(), 0
+		for node := range newer.nodes() {
+			if olderNodes[node] {
+				count++
+			}
+		}
+		return count
+	}
+	newer := tree.Put(500, "x")
+	if actualValue, _ := tree.Get(500); actualValue != 500 {
+		t.Errorf("Got %v expected %v", actualValue, 500)
+	}
+	if count := shared(tree, newer); count < 980 {
+		t.Errorf("Got %v shared nodes expected at least %v", count, 980)
+	}
+	newer = tree.Remove(500)
+	if _, found := tree.Get(500); !found {
+		t.Errorf("Removing from a new version changed the old version")
+	}
+	if count := shared(tree, newer); count < 970 {
+		t.Errorf("Got %v shared nodes expected at least %v", count, 970)
+	}
+	if tree.Remove(1000) != tree {
+		t.Errorf("Removing a missing key should return the same version")
+	}
+	if dotString := versionsDotString(tree, newer); !strings.Contains(dotString, "cluster_newer") || !strings.Contains(dotString, "dashed") || !strings.Contains(dotString, "orange1") {
+		t.Errorf("Got %v", dotString)
+	}
+	dotString := ""
+	tree.dotString(&dotString, "v", nil)
+	if strings.Contains(dotString, "penwidth") {
+		t.Errorf("Got %v expected no highlighted nodes", dotString)
+	}
+}
+
+func TestPersistentAVLTreeHistory(t *testing.T) {
+	history := NewHistory(NewPersistentWithStringComparator())
+	history.Put("a", 1)
+	history.Put("b", 2)
+	history.Remove("a")
+	if actualValue, expectedValue := fmt.Sprint(history.Current().Keys()), "[b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !history.Undo() || !history.Undo() {
+		t.Errorf("Expected to undo twice")
+	}
+	if actualValue, expectedValue := fmt.Sprint(history.Current().Keys()), "[a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !history.Redo() {
+		t.Errorf("Expected to redo")
+	}
+	history.Put("c", 3)
+	if history.Redo() {
+		t.Errorf("Expected redo to fail after a modification")
+	}
+	if actualValue, expectedValue := fmt.Sprint(history.Current().Keys()), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := history.Versions(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if current := history.Current(); history.Remove("x") != current || history.Versions() != 4 {
+		t.Errorf("Got %v expected %v versions after removing a missing key", history.Versions(), 4)
+	}
+	if _, ok := history.Version(-1); ok {
+		t.Errorf("Expected no version -1")
+	}
+}
+
+func assertPersistentTree(t *testing.T, tree *PersistentTree, expectedSize int) {
+	t.Helper()
+	if actualValue := tree.Size(); actualValue != expectedSize {
+		t.Errorf("Got %v expected %v for tree size", actualValue, expectedSize)
+	}
+	if err := tree.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAVLTreeValidate(t *testing.T) {
+	tree := NewWithIntComparator()
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	for i := 1; i <= 20; i++ {
+		tree.Put(i, i)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("Got %v expected nil", err)
+		}
+	}
+
+	tree.Root.b++
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "balance factor") {
+		t.Errorf("Got %v expected a balance factor violation", err)
+	}
+	tree.Root.b--
+
+	node := tree.Right()
+	node.Key = 0
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "successor") {
+		t.Errorf("Got %v expected an order violation", err)
+	}
+	node.Key = 20
+
+	parent := node.Parent
+	node.Parent = nil
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "parent") {
+		t.Errorf("Got %v expected a parent violation", err)
+	}
+	node.Parent = parent
+
+	node.size++
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "size") {
+		t.Errorf("Got %v expected a size violation", err)
+	}
+	node.size--
+
+	// hang a chain of two nodes below the right-most node
+	node.Children[1] = &Node{Key: 21, Parent: node, b: 1, size: 2}
+	node.Children[1].Children[1] = &Node{Key: 22, Parent: node.Children[1], size: 1}
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "heights") {
+		t.Errorf("Got %v expected a height violation", err)
+	}
+	node.Children[1] = nil
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+
+	persistent := NewPersistentWithIntComparator().Put(1, 1).Put(2, 2)
+	persistent.Root.h = 5
+	if err := persistent.Validate(); err == nil {
+		t.Errorf("Got nil expected a violation")
+	}
+}
+
+// assertAVLTree checks the size, parent pointers and balance factors of the tree.
+func assertAVLTree(t *testing.T, tree *Tree, expectedSize int) {
+	t.Helper()
+	if actualValue := tree.Size(); actualValue != expectedSize {
+		t.Errorf("Got %v expected %v for tree size", actualValue, expectedSize)
+	}
+	if err := tree.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAVLTreeSeq(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 5; i > 0; i-- {
+		tree.Put(i, fmt.Sprint(i*10))
+	}
+	actual := []string{}
+	for key, value := range tree.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[1:10 2:20 3:30 4:40 5:50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(tree.SeqKeys())), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(tree.SeqValues())), "[10 20 30 40 50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for key, value := range tree.Backward() {
+		if key == 2 {
+			break
+		}
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[5:50 4:40 3:30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	persistent := NewPersistentWithIntComparator()
+	for i := 5; i > 0; i-- {
+		persistent = persistent.Put(i, fmt.Sprint(i*10))
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(persistent.SeqKeys())), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	actual = actual[:0]
+	for key, value := range persistent.Backward() {
+		if key == 2 {
+			break
+		}
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[5:50 4:40 3:30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for key := range persistent.Seq() {
+		if key == 3 {
+			break
+		}
+		if key.(int) > 3 {
+			t.Errorf("Got %v after breaking", key)
+		}
+	}
+}
+
+func FuzzAVLTree(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckMap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestAVLTreeEnumerable(t *testing.T) {
+	tree := NewWithIntComparator()
+	for i := 1; i <= 6; i++ {
+		tree.Put(i, fmt.Sprint(i))
+	}
+
+	var keys []any
+	tree.Each(func(key any, value any) { keys = append(keys, key) })
+	if actualValue, expectedValue := fmt.Sprint(keys), "[1 2 3 4 5 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := tree.Map(func(key any, value any) (any, any) { return -key.(int), value.(string) + value.(string) })
+	if actualValue, expectedValue := fmt.Sprint(mapped.Keys(), mapped.Values()), "[-6 -5 -4 -3 -2 -1] [66 55 44 33 22 11]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	even := tree.Select(func(key any, value any) bool { return key.(int)%2 == 0 })
+	if actualValue, expectedValue := fmt.Sprint(even.Keys()), "[2 4 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	even.Put(7, "7")
+	if actualValue, expectedValue := fmt.Sprint(even.Keys()), "[2 4 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if tree.Size() != 6 {
+		t.Errorf("Got %v expected %v", tree.Size(), 6)
+	}
+
+	if actualValue := tree.Any(func(key any, value any) bool { return value == "3" }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree.Any(func(key any, value any) bool { return key.(int) > 6 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := tree.All(func(key any, value any) bool { return key.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree.All(func(key any, value any) bool { return key.(int) < 6 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	key, value := tree.Find(func(key any, value any) bool { return key.(int) > 3 })
+	if key != 4 || value != "4" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 4, "4")
+	}
+	key, value = tree.Find(func(key any, value any) bool { return key.(int) > 6 })
+	if key != nil || value != nil {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, nil, nil)
+	}
+}
+
+func BenchmarkAVLTreeFromSorted100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	keys := make([]any, size)
+	values := make([]any, size)
+	for n := 0; n < size; n++ {
+		keys[n], values[n] = n, struct{}{}
+	}
+	tree := NewWithIntComparator()
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		tree.FromSorted(keys, values)
+	}
+}
+
 func benchmarkGet(b *testing.B, tree *Tree, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
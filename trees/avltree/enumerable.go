@@ -0,0 +1,76 @@
+package avltree
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.EnumerableWithKey = (*Tree)(nil)
+
+// Each calls the given function once for each element, passing that element's key and value.
+func (t *Tree) Each(f func(key any, value any)) {
+	iterator := t.Iterator()
+	for iterator.Next() {
+		f(iterator.Key(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+// The returned tree uses the same comparator as the tree.
+func (t *Tree) Map(f func(key1 any, value1 any) (any, any)) *Tree {
+	newTree := NewWith(t.Comparator)
+	iterator := t.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		newTree.Put(key2, value2)
+	}
+	return newTree
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// The returned tree uses the same comparator as the tree.
+func (t *Tree) Select(f func(key any, value any) bool) *Tree {
+	newTree := NewWith(t.Comparator)
+	iterator := t.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			newTree.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return newTree
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (t *Tree) Any(f func(key any, value any) bool) bool {
+	iterator := t.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (t *Tree) All(f func(key any, value any) bool) bool {
+	iterator := t.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Key(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or nil,nil otherwise if no element
+// matches the criteria.
+func (t *Tree) Find(f func(key any, value any) bool) (any, any) {
+	iterator := t.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return iterator.Key(), iterator.Value()
+		}
+	}
+	return nil, nil
+}
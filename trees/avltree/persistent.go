@@ -0,0 +1,357 @@
+package avltree
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/trees/versions"
+)
+
+// PersistentTree is an immutable variant of the AVL tree.
+//
+// Put and Remove never modify a tree, instead they return a new version of it. Only the nodes on the path
+// from the root to the modified node are copied, all other nodes are shared between both versions (path copying),
+// so every modification allocates O(log n) nodes. Old versions stay valid and may be used concurrently.
+//
+// Reference: https://en.wikipedia.org/wiki/Persistent_data_structure
+type PersistentTree struct {
+	Root       *PersistentNode
+	Comparator utils.Comparator
+	size       int
+}
+
+// PersistentNode is a single element within the persistent tree.
+// Nodes may be shared by several versions of the tree and must not be modified.
+type PersistentNode struct {
+	Key      any
+	Value    any
+	Children [2]*PersistentNode
+	h        int
+}
+
+// NewPersistentWith instantiates an empty persistent AVL tree with the custom comparator.
+func NewPersistentWith(comparator utils.Comparator) *PersistentTree {
+	return &PersistentTree{Comparator: comparator}
+}
+
+// NewPersistentWithIntComparator instantiates an empty persistent AVL tree with the IntComparator, i.e. keys are of type int.
+func NewPersistentWithIntComparator() *PersistentTree {
+	return &PersistentTree{Comparator: utils.IntComparator}
+}
+
+// NewPersistentWithStringComparator instantiates an empty persistent AVL tree with the StringComparator, i.e. keys are of type string.
+func NewPersistentWithStringComparator() *PersistentTree {
+	return &PersistentTree{Comparator: utils.StringComparator}
+}
+
+// Put returns a new version of the tree with the key-value pair inserted.
+// If the key already exists, its value is replaced in the new version.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *PersistentTree) Put(key any, value any) *PersistentTree {
+	root, added := t.put(t.Root, key, value)
+	v := &PersistentTree{Root: root, Comparator: t.Comparator, size: t.size}
+	if added {
+		v.size++
+	}
+	return v
+}
+
+// Remove returns a new version of the tree with the key removed.
+// If the key is not found, the tree itself is returned.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *PersistentTree) Remove(key any) *PersistentTree {
+	root, removed := t.remove(t.Root, key)
+	if !removed {
+		return t
+	}
+	return &PersistentTree{Root: root, Comparator: t.Comparator, size: t.size - 1}
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *PersistentTree) Get(key any) (value any, found bool) {
+	n := t.Root
+	for n != nil {
+		cmp := t.Comparator(key, n.Key)
+		switch {
+		case cmp == 0:
+			return n.Value, true
+		case cmp < 0:
+			n = n.Children[0]
+		case cmp > 0:
+			n = n.Children[1]
+		}
+	}
+	return nil, false
+}
+
+// Empty returns true if tree does not contain any nodes.
+func (t *PersistentTree) Empty() bool {
+	return t.size == 0
+}
+
+// Size returns the number of elements stored in the tree.
+func (t *PersistentTree) Size() int {
+	return t.size
+}
+
+// Keys returns all keys in-order
+func (t *PersistentTree) Keys() []any {
+	keys := make([]any, 0, t.size)
+	t.Root.walk(func(n *PersistentNode) {
+		keys = append(keys, n.Key)
+	})
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (t *PersistentTree) Values() []any {
+	values := make([]any, 0, t.size)
+	t.Root.walk(func(n *PersistentNode) {
+		values = append(values, n.Value)
+	})
+	return values
+}
+
+// Left returns the minimum element of the AVL tree
+// or nil if the tree is empty.
+func (t *PersistentTree) Left() *PersistentNode {
+	return t.bottom(0)
+}
+
+// Right returns the maximum element of the AVL tree
+// or nil if the tree is empty.
+func (t *PersistentTree) Right() *PersistentNode {
+	return t.bottom(1)
+}
+
+// String returns a string representation of container
+func (t *PersistentTree) String() string {
+	str := "PersistentAVLTree\n"
+	if !t.Empty() {
+		t.Root.output("", true, &str)
+	}
+	return str
+}
+
+func (n *PersistentNode) String() string {
+	return fmt.Sprintf("%v", n.Key)
+}
+
+// Visualizer makes a visual image demonstrating the persistent avl tree
+// using dot language and Graphviz.
+func (t *PersistentTree) Visualizer(fileName string) bool {
+	dotString := "digraph graphname{bgcolor=white;"
+	t.dotString(&dotString, "v", nil)
+	dotString += "}"
+	return utils.WriteDotStringToPng(fileName, dotString)
+}
+
+// VisualizeVersions makes a visual image of two versions of a persistent avl tree side by side
+// using dot language and Graphviz. Nodes shared by both versions are drawn with a dashed gray border,
+// while nodes which exist in one version only, i.e. the nodes copied by path copying, are drawn with a thick orange border.
+func VisualizeVersions(fileName string, older *PersistentTree, newer *PersistentTree) bool {
+	return utils.WriteDotStringToPng(fileName, versionsDotString(older, newer))
+}
+
+// History records the versions of a persistent AVL tree and allows to undo and redo modifications.
+type History = versions.History[*PersistentTree]
+
+// NewHistory instantiates a history starting with the given tree as its first version.
+func NewHistory(tree *PersistentTree) *History {
+	return versions.NewHistory(tree)
+}
+
+func (t *PersistentTree) put(n *PersistentNode, key any, value any) (*PersistentNode, bool) {
+	if n == nil {
+		return &PersistentNode{Key: key, Value: value, h: 1}, true
+	}
+	n = n.clone()
+	c := t.Comparator(key, n.Key)
+	if c == 0 {
+		n.Key, n.Value = key, value
+		return n, false
+	}
+	a := 0
+	if c > 0 {
+		a = 1
+	}
+	var added bool
+	n.Children[a], added = t.put(n.Children[a], key, value)
+	return n.balance(), added
+}
+
+func (t *PersistentTree) remove(n *PersistentNode, key any) (*PersistentNode, bool) {
+	if n == nil {
+		return nil, false
+	}
+	c := t.Comparator(key, n.Key)
+	if c == 0 {
+		if n.Children[0] == nil {
+			return n.Children[1], true
+		}
+		if n.Children[1] == nil {
+			return n.Children[0], true
+		}
+		n = n.clone()
+		var min *PersistentNode
+		n.Children[1], min = n.Children[1].removeMin()
+		n.Key, n.Value = min.Key, min.Value
+		return n.balance(), true
+	}
+	a := 0
+	if c > 0 {
+		a = 1
+	}
+	q, removed := t.remove(n.Children[a], key)
+	if !removed {
+		return n, false
+	}
+	n = n.clone()
+	n.Children[a] = q
+	return n.balance(), true
+}
+
+// removeMin returns a copy of the subtree without its minimum element, and the minimum element.
+func (n *PersistentNode) removeMin() (*PersistentNode, *PersistentNode) {
+	if n.Children[0] == nil {
+		return n.Children[1], n
+	}
+	n = n.clone()
+	var min *PersistentNode
+	n.Children[0], min = n.Children[0].removeMin()
+	return n.balance(), min
+}
+
+// balance restores the AVL property of the copied node n, whose subtrees differ in height by two at most,
+// copying any child it rotates.
+func (n *PersistentNode) balance() *PersistentNode {
+	for a := 0; a < 2; a++ {
+		if n.Children[a].height()-n.Children[a^1].height() < 2 {
+			continue
+		}
+		if s := n.Children[a]; s.Children[a^1].height() > s.Children[a].height() {
+			n.Children[a] = s.clone().rotate(a ^ 1)
+		}
+		return n.rotate(a)
+	}
+	n.update()
+	return n
+}
+
+// rotate lifts a copy of the child a of the copied node n and returns it.
+func (n *PersistentNode) rotate(a int) *PersistentNode {
+	r := n.Children[a].clone()
+	n.Children[a] = r.Children[a^1]
+	r.Children[a^1] = n
+	n.update()
+	r.update()
+	return r
+}
+
+func (n *PersistentNode) update() {
+	n.h = n.Children[0].height()
+	if h := n.Children[1].height(); h > n.h {
+		n.h = h
+	}
+	n.h++
+}
+
+func (n *PersistentNode) height() int {
+	if n == nil {
+		return 0
+	}
+	return n.h
+}
+
+func (n *PersistentNode) clone() *PersistentNode {
+	c := *n
+	return &c
+}
+
+func (t *PersistentTree) bottom(d int) *PersistentNode {
+	n := t.Root
+	if n == nil {
+		return nil
+	}
+
+	for c := n.Children[d]; c != nil; c = n.Children[d] {
+		n = c
+	}
+	return n
+}
+
+func (n *PersistentNode) walk(visit func(n *PersistentNode)) {
+	if n == nil {
+		return
+	}
+	n.Children[0].walk(visit)
+	visit(n)
+	n.Children[1].walk(visit)
+}
+
+func (n *PersistentNode) output(prefix string, isTail bool, str *string) {
+	if n.Children[1] != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		n.Children[1].output(newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += n.String() + "\n"
+	if n.Children[0] != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		n.Children[0].output(newPrefix, true, str)
+	}
+}
+
+// versionsDotString returns the dot string drawing both versions in separate clusters.
+func versionsDotString(older *PersistentTree, newer *PersistentTree) string {
+	olderNodes, newerNodes := older.nodes(), newer.nodes()
+	return versions.DotString(func(dotString *string, prefix string) {
+		older.dotString(dotString, prefix, newerNodes)
+	}, func(dotString *string, prefix string) {
+		newer.dotString(dotString, prefix, olderNodes)
+	})
+}
+
+// nodes returns the set of all nodes of the tree.
+func (t *PersistentTree) nodes() map[*PersistentNode]bool {
+	nodes := make(map[*PersistentNode]bool, t.size)
+	t.Root.walk(func(n *PersistentNode) {
+		nodes[n] = true
+	})
+	return nodes
+}
+
+// dotString appends the nodes and edges of the tree to the dot string, naming nodes by the given prefix
+// and their in-order index. If other is not nil, nodes are marked as shared or not shared with the other version.
+func (t *PersistentTree) dotString(dotString *string, prefix string, other map[*PersistentNode]bool) {
+	index := make(map[*PersistentNode]string, t.size)
+	t.Root.walk(func(n *PersistentNode) {
+		index[n] = prefix + strconv.Itoa(len(index))
+	})
+	t.Root.walk(func(n *PersistentNode) {
+		for _, c := range n.Children {
+			if c != nil {
+				*dotString += index[n] + " -> " + index[c] + ";"
+			}
+		}
+		*dotString += index[n] + versions.NodeAttributes(n, "orange1", fmt.Sprintf("%v->%v", n.Key, n.Value), other)
+	})
+}
@@ -0,0 +1,74 @@
+package avltree
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the keys and values of the tree in-order, for use with range.
+func (tree *Tree) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		for it := tree.Iterator(); it.Next(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the tree in-order, for use with range.
+func (tree *Tree) SeqKeys() iter.Seq[any] {
+	return seq.Keys(tree.Seq())
+}
+
+// SeqValues returns a sequence of the values of the tree in-order based on the key, for use with range.
+func (tree *Tree) SeqValues() iter.Seq[any] {
+	return seq.Values(tree.Seq())
+}
+
+// Backward returns a sequence of the keys and values of the tree in reverse order, for use with range.
+func (tree *Tree) Backward() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		it := tree.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Seq returns a sequence of the keys and values of the tree in-order, for use with range.
+func (t *PersistentTree) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		t.Root.ascend(yield)
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the tree in-order, for use with range.
+func (t *PersistentTree) SeqKeys() iter.Seq[any] {
+	return seq.Keys(t.Seq())
+}
+
+// SeqValues returns a sequence of the values of the tree in-order based on the key, for use with range.
+func (t *PersistentTree) SeqValues() iter.Seq[any] {
+	return seq.Values(t.Seq())
+}
+
+// Backward returns a sequence of the keys and values of the tree in reverse order, for use with range.
+func (t *PersistentTree) Backward() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		t.Root.descend(yield)
+	}
+}
+
+// ascend yields the keys and values of the subtree in-order and returns false once yield does.
+func (n *PersistentNode) ascend(yield func(key any, value any) bool) bool {
+	return n == nil || (n.Children[0].ascend(yield) && yield(n.Key, n.Value) && n.Children[1].ascend(yield))
+}
+
+// descend yields the keys and values of the subtree in reverse order and returns false once yield does.
+func (n *PersistentNode) descend(yield func(key any, value any) bool) bool {
+	return n == nil || (n.Children[1].descend(yield) && yield(n.Key, n.Value) && n.Children[0].descend(yield))
+}
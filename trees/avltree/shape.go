@@ -0,0 +1,106 @@
+package avltree
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+// shapeNode is the JSON representation of a node within the tree's shape.
+type shapeNode struct {
+	Key     json.RawMessage `json:"key"`
+	Value   any             `json:"value"`
+	Balance int8            `json:"balance"`
+	Left    *shapeNode      `json:"left,omitempty"`
+	Right   *shapeNode      `json:"right,omitempty"`
+}
+
+// ToShapeJSON outputs the JSON representation of the tree's exact shape, i.e. every node with its balance factor and children:
+//
+//	{"root":{"key":{"type":"int","value":2},"value":"b","balance":0,"left":{...},"right":{...}}}
+//
+// The balance factor is the height of the right subtree minus the height of the left one.
+// Keys are encoded by the tree's KeyCodec, or by keycodec.Typed if the tree has none.
+// Unlike ToJSON, whose output may be loaded into a tree of a different shape, FromShapeJSON restores the same tree node by node,
+// e.g. to save a scenario shown by the Visualizer and reproduce it later.
+func (t *Tree) ToShapeJSON() ([]byte, error) {
+	codec := t.keyCodec()
+	var encode func(n *Node) (*shapeNode, error)
+	encode = func(n *Node) (*shapeNode, error) {
+		if n == nil {
+			return nil, nil
+		}
+		key, err := codec.EncodeKey(n.Key)
+		if err != nil {
+			return nil, err
+		}
+		shape := &shapeNode{Key: key, Value: n.Value, Balance: n.b}
+		if shape.Left, err = encode(n.Children[0]); err != nil {
+			return nil, err
+		}
+		if shape.Right, err = encode(n.Children[1]); err != nil {
+			return nil, err
+		}
+		return shape, nil
+	}
+	root, err := encode(t.Root)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Root *shapeNode `json:"root"`
+	}{root})
+}
+
+// FromShapeJSON replaces the tree's elements with the nodes of the input JSON representation output by ToShapeJSON,
+// keeping their balance factors and children as they are.
+// Returns an error and leaves the tree unchanged if the input does not represent a valid AVL tree (see Validate).
+// Returns an error as well if the comparator cannot compare the decoded keys.
+func (t *Tree) FromShapeJSON(data []byte) error {
+	var shape struct {
+		Root *shapeNode `json:"root"`
+	}
+	if err := json.Unmarshal(data, &shape); err != nil {
+		return err
+	}
+	codec := t.keyCodec()
+	var keys []any
+	var decode func(shape *shapeNode, parent *Node) (*Node, error)
+	decode = func(shape *shapeNode, parent *Node) (*Node, error) {
+		if shape == nil {
+			return nil, nil
+		}
+		if shape.Key == nil {
+			return nil, errors.New("avltree: node has no key")
+		}
+		n := &Node{Value: shape.Value, Parent: parent, b: shape.Balance}
+		var err error
+		if n.Key, err = codec.DecodeKey(shape.Key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, n.Key)
+		if n.Children[0], err = decode(shape.Left, n); err != nil {
+			return nil, err
+		}
+		if n.Children[1], err = decode(shape.Right, n); err != nil {
+			return nil, err
+		}
+		n.size = 1 + nodeSize(n.Children[0]) + nodeSize(n.Children[1])
+		return n, nil
+	}
+	root, err := decode(shape.Root, nil)
+	if err != nil {
+		return err
+	}
+	if err := keycodec.CheckKeys(keys, t.Comparator); err != nil {
+		return err
+	}
+	loaded := &Tree{Root: root, Comparator: t.Comparator, size: nodeSize(root)}
+	if err := loaded.Validate(); err != nil {
+		return err
+	}
+	t.Clear()
+	t.Root, t.size = loaded.Root, loaded.size
+	return nil
+}
@@ -0,0 +1,147 @@
+package avltree
+
+import "errors"
+
+// Split moves all nodes of the tree into two new trees, the left one holding the keys smaller than the given key
+// and the right one holding the keys larger than or equal to the given key. The tree is left empty.
+//
+// Split takes O(log n) time, nodes are relinked instead of copied.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (t *Tree) Split(key any) (left *Tree, right *Tree) {
+	l, _, r, _ := t.split(t.Root, height(t.Root), key)
+	left, right = NewWith(t.Comparator), NewWith(t.Comparator)
+	left.KeyCodec, right.KeyCodec = t.KeyCodec, t.KeyCodec
+	left.Root, left.size = detach(l), nodeSize(l)
+	right.Root, right.size = detach(r), nodeSize(r)
+	t.Clear()
+	return left, right
+}
+
+// Join moves all nodes of the other tree into the tree.
+// All keys of the tree must be smaller than all keys of the other tree, otherwise an error is returned and both trees are left unchanged.
+// The other tree is left empty. If the tree has no KeyCodec, it takes over the other tree's KeyCodec.
+//
+// Join takes O(log n) time, nodes are relinked instead of copied.
+func (t *Tree) Join(other *Tree) error {
+	if other.Empty() {
+		return nil
+	}
+	if !t.Empty() && t.Comparator(t.Right().Key, other.Left().Key) >= 0 {
+		return errors.New("avltree: keys of the joined trees overlap")
+	}
+	if t.KeyCodec == nil {
+		t.KeyCodec = other.KeyCodec
+	}
+	size := t.size + other.size
+	first := other.Left()
+	m := &Node{Key: first.Key, Value: first.Value}
+	other.Remove(first.Key)
+	n, _ := join(t.Root, height(t.Root), m, other.Root, height(other.Root))
+	t.Root, t.size = detach(n), size
+	other.Clear()
+	return nil
+}
+
+// split splits the subtree rooted at n of height h into the subtrees of keys smaller and
+// larger than or equal to the key, returning them together with their heights.
+func (t *Tree) split(n *Node, h int, key any) (l *Node, lh int, r *Node, rh int) {
+	if n == nil {
+		return nil, 0, nil, 0
+	}
+	nlh, nrh := childHeights(n, h)
+	nl, nr := detach(n.Children[0]), detach(n.Children[1])
+	n.Children = [2]*Node{}
+	if t.Comparator(key, n.Key) <= 0 {
+		l, lh, r, rh = t.split(nl, nlh, key)
+		r, rh = join(r, rh, n, nr, nrh)
+		return l, lh, detach(r), rh
+	}
+	l, lh, r, rh = t.split(nr, nrh, key)
+	l, lh = join(nl, nlh, n, l, lh)
+	return detach(l), lh, r, rh
+}
+
+// join links the AVL subtrees l and r of heights lh and rh through the node m, whose key lies between the keys
+// of both subtrees, and returns the root and height of the resulting AVL tree.
+// The node m is hung into the taller subtree at the height of the shorter one and the subtrees on
+// the way back up are rebalanced, so the work is proportional to the difference of both heights.
+func join(l *Node, lh int, m *Node, r *Node, rh int) (*Node, int) {
+	switch {
+	case lh > rh+1:
+		llh, lrh := childHeights(l, lh)
+		n, h := join(l.Children[1], lrh, m, r, rh)
+		l.Children[1], n.Parent = n, l
+		return rebalance(l, llh, h)
+	case rh > lh+1:
+		rlh, rrh := childHeights(r, rh)
+		n, h := join(l, lh, m, r.Children[0], rlh)
+		r.Children[0], n.Parent = n, r
+		return rebalance(r, h, rrh)
+	}
+	m.Children = [2]*Node{l, r}
+	for _, c := range m.Children {
+		if c != nil {
+			c.Parent = m
+		}
+	}
+	return rebalance(m, lh, rh)
+}
+
+// rebalance restores the balance of n, whose children have the heights lh and rh differing by two at most,
+// and returns the new root of the subtree together with its height.
+func rebalance(n *Node, lh int, rh int) (*Node, int) {
+	n.updateSize()
+	var c int8 = 1
+	h := rh
+	if lh > rh {
+		c, h = -1, lh
+	}
+	if d := rh - lh; d >= -1 && d <= 1 {
+		n.b = int8(d)
+		return n, h + 1
+	}
+	switch s := n.Children[(c+1)/2]; s.b {
+	case c:
+		return singlerot(c, n), h
+	case -c:
+		return doublerot(c, n), h
+	default:
+		s = rotate(c, n)
+		n.b = c
+		s.b = -c
+		return s, h + 1
+	}
+}
+
+// childHeights returns the heights of the children of n of height h.
+func childHeights(n *Node, h int) (int, int) {
+	switch n.b {
+	case -1:
+		return h - 1, h - 2
+	case 1:
+		return h - 2, h - 1
+	}
+	return h - 1, h - 1
+}
+
+// height returns the number of nodes on the longest path from n down to a leaf.
+func height(n *Node) int {
+	h := 0
+	for n != nil {
+		h++
+		if n.b > 0 {
+			n = n.Children[1]
+		} else {
+			n = n.Children[0]
+		}
+	}
+	return h
+}
+
+// detach cuts the node off its parent and returns it.
+func detach(n *Node) *Node {
+	if n != nil {
+		n.Parent = nil
+	}
+	return n
+}
@@ -0,0 +1,106 @@
+package avltree
+
+import "fmt"
+
+// Validate checks the structural invariants of the tree and returns an error describing the first violation found,
+// or nil if the tree is a valid AVL tree.
+//
+// Checked are the balance factors against the actual heights of the subtrees, the parent pointers,
+// the order of the keys, the subtree sizes and the tree's size.
+// Validation visits every node, so it is meant for tests and debugging.
+func (t *Tree) Validate() error {
+	if t.Root != nil && t.Root.Parent != nil {
+		return fmt.Errorf("avltree: root %v has parent %v", t.Root, t.Root.Parent)
+	}
+	var prev *Node
+	var validate func(n *Node) (int, error)
+	validate = func(n *Node) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+		for _, c := range n.Children {
+			if c != nil && c.Parent != n {
+				return 0, fmt.Errorf("avltree: node %v has parent %v instead of %v", c, c.Parent, n)
+			}
+		}
+		lh, err := validate(n.Children[0])
+		if err != nil {
+			return 0, err
+		}
+		if prev != nil && t.Comparator(prev.Key, n.Key) >= 0 {
+			return 0, fmt.Errorf("avltree: key %v is not smaller than its successor %v", prev.Key, n.Key)
+		}
+		prev = n
+		rh, err := validate(n.Children[1])
+		if err != nil {
+			return 0, err
+		}
+		if rh-lh < -1 || rh-lh > 1 {
+			return 0, fmt.Errorf("avltree: subtrees of node %v have heights %v and %v", n, lh, rh)
+		}
+		if int(n.b) != rh-lh {
+			return 0, fmt.Errorf("avltree: node %v has balance factor %v instead of %v", n, n.b, rh-lh)
+		}
+		if size := 1 + nodeSize(n.Children[0]) + nodeSize(n.Children[1]); n.size != size {
+			return 0, fmt.Errorf("avltree: node %v has subtree size %v instead of %v", n, n.size, size)
+		}
+		if lh > rh {
+			return lh + 1, nil
+		}
+		return rh + 1, nil
+	}
+	if _, err := validate(t.Root); err != nil {
+		return err
+	}
+	if size := nodeSize(t.Root); size != t.size {
+		return fmt.Errorf("avltree: tree has size %v but holds %v nodes", t.size, size)
+	}
+	return nil
+}
+
+// Validate checks the structural invariants of the persistent tree and returns an error describing the first violation found,
+// or nil if the tree is a valid AVL tree.
+//
+// Checked are the balance and the stored heights of the subtrees, the order of the keys and the tree's size.
+// Validation visits every node, so it is meant for tests and debugging.
+func (t *PersistentTree) Validate() error {
+	var prev *PersistentNode
+	size := 0
+	var validate func(n *PersistentNode) (int, error)
+	validate = func(n *PersistentNode) (int, error) {
+		if n == nil {
+			return 0, nil
+		}
+		lh, err := validate(n.Children[0])
+		if err != nil {
+			return 0, err
+		}
+		if prev != nil && t.Comparator(prev.Key, n.Key) >= 0 {
+			return 0, fmt.Errorf("avltree: key %v is not smaller than its successor %v", prev.Key, n.Key)
+		}
+		prev = n
+		size++
+		rh, err := validate(n.Children[1])
+		if err != nil {
+			return 0, err
+		}
+		if rh-lh < -1 || rh-lh > 1 {
+			return 0, fmt.Errorf("avltree: subtrees of node %v have heights %v and %v", n, lh, rh)
+		}
+		h := lh
+		if rh > h {
+			h = rh
+		}
+		if n.h != h+1 {
+			return 0, fmt.Errorf("avltree: node %v has height %v instead of %v", n, n.h, h+1)
+		}
+		return h + 1, nil
+	}
+	if _, err := validate(t.Root); err != nil {
+		return err
+	}
+	if size != t.size {
+		return fmt.Errorf("avltree: tree has size %v but holds %v nodes", t.size, size)
+	}
+	return nil
+}
@@ -9,15 +9,18 @@ package binaryheap
 
 import (
 	"fmt"
+	"iter"
 	"strconv"
 	"strings"
 
 	"github.com/Arafatk/Dataviz/lists/arraylist"
 	"github.com/Arafatk/Dataviz/trees"
 	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/trees/heaps"
 )
 
 var _ trees.Tree = (*Heap)(nil)
+var _ heaps.Heap = (*Heap)(nil)
 
 // Heap holds elements in an array-list
 type Heap struct {
@@ -40,20 +43,39 @@ func NewWithStringComparator() *Heap {
 	return &Heap{list: arraylist.New(), Comparator: utils.StringComparator}
 }
 
+// NewFromValues instantiates a new heap with the custom comparator holding the values.
+// The heap is built bottom-up in linear time, rather than by pushing the values one at a time.
+func NewFromValues(comparator utils.Comparator, values ...any) *Heap {
+	heap := NewWith(comparator)
+	heap.list.Add(values...)
+	heap.heapify()
+	return heap
+}
+
 // Push adds a value onto the heap and bubbles it up accordingly.
 func (heap *Heap) Push(values ...any) {
 	if len(values) == 1 {
 		heap.list.Add(values[0])
 		heap.bubbleUp()
 	} else {
-		// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
-		for _, value := range values {
-			heap.list.Add(value)
-		}
-		size := heap.list.Size()/2 + 1
-		for i := size; i >= 0; i-- {
-			heap.bubbleDownIndex(i)
+		heap.list.Add(values...)
+		heap.heapify()
+	}
+}
+
+// PushAll adds the values of the sequence onto the heap.
+// Fewer values than the heap holds are bubbled up one at a time, otherwise the whole heap is rebuilt bottom-up in linear time.
+func (heap *Heap) PushAll(values iter.Seq[any]) {
+	size := heap.list.Size()
+	for value := range values {
+		heap.list.Add(value)
+	}
+	if added := heap.list.Size() - size; added < size {
+		for index := size; index < size+added; index++ {
+			heap.bubbleUpIndex(index)
 		}
+	} else {
+		heap.heapify()
 	}
 }
 
@@ -117,23 +139,15 @@ func (heap *Heap) bubbleDown() {
 // Performs the "bubble down" operation. This is to place the element that is at the index
 // of the heap in its correct place so that the heap maintains the min/max-heap order property.
 func (heap *Heap) bubbleDownIndex(index int) {
-	size := heap.list.Size()
-	for leftIndex := index<<1 + 1; leftIndex < size; leftIndex = index<<1 + 1 {
-		rightIndex := index<<1 + 2
-		smallerIndex := leftIndex
-		leftValue, _ := heap.list.Get(leftIndex)
-		rightValue, _ := heap.list.Get(rightIndex)
-		if rightIndex < size && heap.Comparator(leftValue, rightValue) > 0 {
-			smallerIndex = rightIndex
-		}
-		indexValue, _ := heap.list.Get(index)
-		smallerValue, _ := heap.list.Get(smallerIndex)
-		if heap.Comparator(indexValue, smallerValue) > 0 {
-			heap.list.Swap(index, smallerIndex)
-		} else {
-			break
-		}
-		index = smallerIndex
+	SiftDown(index, heap.list.Size(), heap.compare, heap.list.Swap)
+}
+
+// Restores the min/max-heap order property of all elements by bubbling down every parent,
+// starting from the last one, which takes linear time.
+// Reference: https://en.wikipedia.org/wiki/Binary_heap#Building_a_heap
+func (heap *Heap) heapify() {
+	for index := heap.list.Size()/2 - 1; index >= 0; index-- {
+		heap.bubbleDownIndex(index)
 	}
 }
 
@@ -170,14 +184,48 @@ func (heap *Heap) Visualizer(fileName string) bool {
 // element (i.e. last element in the list) in its correct place so that
 // the heap maintains the min/max-heap order property.
 func (heap *Heap) bubbleUp() {
-	index := heap.list.Size() - 1
+	heap.bubbleUpIndex(heap.list.Size() - 1)
+}
+
+// Performs the "bubble up" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap) bubbleUpIndex(index int) {
+	SiftUp(index, heap.compare, heap.list.Swap)
+}
+
+// compare compares the elements at the indices i and j with the heap's comparator.
+func (heap *Heap) compare(i, j int) int {
+	a, _ := heap.list.Get(i)
+	b, _ := heap.list.Get(j)
+	return heap.Comparator(a, b)
+}
+
+// SiftDown moves the element at the index down the implicit binary tree of the first size elements
+// until none of its children is ordered before it, swapping it with the child ordered first.
+// The elements are only accessed through compare and swap, which lets the heaps of this package track their positions
+// and lets other code keep a heap within a slice of its own, e.g. utils.HeapSort.
+func SiftDown(index int, size int, compare func(i, j int) int, swap func(i, j int)) {
+	for leftIndex := index<<1 + 1; leftIndex < size; leftIndex = index<<1 + 1 {
+		rightIndex := index<<1 + 2
+		smallerIndex := leftIndex
+		if rightIndex < size && compare(leftIndex, rightIndex) > 0 {
+			smallerIndex = rightIndex
+		}
+		if compare(index, smallerIndex) <= 0 {
+			break
+		}
+		swap(index, smallerIndex)
+		index = smallerIndex
+	}
+}
+
+// SiftUp moves the element at the index up the implicit binary tree until its parent is not ordered after it.
+func SiftUp(index int, compare func(i, j int) int, swap func(i, j int)) {
 	for parentIndex := (index - 1) >> 1; index > 0; parentIndex = (index - 1) >> 1 {
-		indexValue, _ := heap.list.Get(index)
-		parentValue, _ := heap.list.Get(parentIndex)
-		if heap.Comparator(parentValue, indexValue) <= 0 {
+		if compare(parentIndex, index) <= 0 {
 			break
 		}
-		heap.list.Swap(index, parentIndex)
+		swap(index, parentIndex)
 		index = parentIndex
 	}
 }
@@ -1,8 +1,17 @@
 package binaryheap
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
 	"math/rand"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/containertest"
 )
 
 func TestBinaryHeapPush(t *testing.T) {
@@ -43,6 +52,81 @@ func TestBinaryHeapPushBulk(t *testing.T) {
 	}
 }
 
+func TestBinaryHeapNewFromValues(t *testing.T) {
+	heap := NewFromValues(utils.IntComparator, 15, 20, 3, 1, 2)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := heap.Size(), 5; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var popped []any
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		popped = append(popped, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[1 2 3 15 20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := NewFromValues(utils.IntComparator); !actualValue.Empty() {
+		t.Errorf("Got %v expected %v", actualValue.Size(), 0)
+	}
+}
+
+func TestBinaryHeapPushAll(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.PushAll(slices.Values([]any{5, 3, 8}))
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	for _, count := range []int{1, 2, 10, 100} {
+		values := make([]any, count)
+		for i := range values {
+			values[i] = rand.Intn(1000)
+		}
+		heap.PushAll(slices.Values(values))
+		if err := heap.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+	if actualValue, expectedValue := heap.Size(), 116; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	previous := -1
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		if value.(int) < previous {
+			t.Errorf("Got %v after %v", value, previous)
+		}
+		previous = value.(int)
+	}
+}
+
+func TestBinaryHeapFromJSONHeapify(t *testing.T) {
+	heap := NewWith(utils.Float64Comparator) // JSON numbers are decoded as float64
+	if err := heap.FromJSON([]byte(`[9,8,7,6,5,4,3,2,1]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 1.0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	for i := 0; i < 200; i++ {
+		values := rand.Perm(i)
+		data, _ := json.Marshal(values)
+		if err := heap.FromJSON(data); err != nil {
+			t.Errorf("Got error %v", err)
+		}
+		if err := heap.Validate(); err != nil {
+			t.Error(err)
+		}
+		if actualValue, expectedValue := heap.Size(), i; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func TestBinaryHeapPop(t *testing.T) {
 	heap := NewWithIntComparator()
 
@@ -287,6 +371,171 @@ func TestBinaryHeapSerialization(t *testing.T) {
 	assert()
 }
 
+func TestBinaryHeapBinary(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(5, 3, 8, 1, int(-2))
+	data, err := heap.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator()
+	loaded.Push(100)
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Values()), fmt.Sprint(heap.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := (&Heap{}).UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for a heap without a comparator")
+	}
+
+	// the heap property is restored for data of another order
+	reversed := NewWith(func(a, b any) int { return b.(int) - a.(int) })
+	if err := reversed.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, _ := reversed.Peek(); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(heap); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := NewWithIntComparator()
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, _ := decoded.Pop(); actualValue != -2 {
+		t.Errorf("Got %v expected %v", actualValue, -2)
+	}
+}
+
+func TestBinaryHeapWriteReadJSON(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2)
+	var buffer bytes.Buffer
+	if err := heap.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	json, _ := heap.ToJSON()
+	if actualValue, expectedValue := buffer.String(), string(json); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := NewWith(func(a, b any) int { return utils.Float64Comparator(b, a) })
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, _ := loaded.Peek(); actualValue != 3.0 {
+		t.Errorf("Got %v expected %v", actualValue, 3.0)
+	}
+}
+
+func TestBinaryHeapMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		Heap *Heap
+	}
+	heap := NewWithStringComparator()
+	heap.Push("c", "a", "b")
+	data, err := json.Marshal(document{Name: "letters", Heap: heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	decoded := document{Heap: NewWithStringComparator()}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := decoded.Heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	var popped []any
+	for value, ok := decoded.Heap.Pop(); ok; value, ok = decoded.Heap.Pop() {
+		popped = append(popped, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a heap without a comparator")
+	}
+}
+
+func TestBinaryHeapValidate(t *testing.T) {
+	heap := NewWithIntComparator()
+	if err := heap.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	for i := 0; i < 100; i++ {
+		heap.Push(rand.Intn(50))
+		if err := heap.Validate(); err != nil {
+			t.Errorf("Got %v expected nil", err)
+		}
+	}
+	heap.Push(1, 5, 3, 8, 2, 9, 0)
+	if err := heap.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	for !heap.Empty() {
+		heap.Pop()
+		if err := heap.Validate(); err != nil {
+			t.Errorf("Got %v expected nil", err)
+		}
+	}
+
+	heap.Push(1, 2, 3)
+	heap.list.Remove(2)
+	heap.list.Add(0)
+	if err := heap.Validate(); err == nil || !strings.Contains(err.Error(), "index 2") {
+		t.Errorf("Got %v expected a heap order violation", err)
+	}
+}
+
+func TestBinaryHeapSeq(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3)
+	heap.Push(1)
+	heap.Push(2)
+	actual := []string{}
+	for index, value := range heap.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:1 1:3 2:2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(heap.SeqValues())), "[1 3 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for index, value := range heap.Backward() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+		if index == 1 {
+			break
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:2 1:3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzBinaryHeap(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckHeap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
 func benchmarkPush(b *testing.B, heap *Heap, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -303,6 +552,205 @@ func benchmarkPop(b *testing.B, heap *Heap, size int) {
 	}
 }
 
+func TestBinaryHeapEnumerable(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(3, 1, 2, 5, 4)
+
+	sum := 0
+	heap.Each(func(index int, value any) { sum += value.(int) })
+	if sum != 15 {
+		t.Errorf("Got %v expected %v", sum, 15)
+	}
+
+	mapped := heap.Map(func(index int, value any) any { return -value.(int) })
+	if actualValue, _ := mapped.Peek(); actualValue != -5 {
+		t.Errorf("Got %v expected %v", actualValue, -5)
+	}
+	if err := mapped.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	even := heap.Select(func(index int, value any) bool { return value.(int)%2 == 0 })
+	even.Push(0)
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(even.SeqValues())), "[0 4 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if actualValue := heap.Any(func(index int, value any) bool { return value == 5 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.All(func(index int, value any) bool { return value.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	index, value := heap.Find(func(index int, value any) bool { return value.(int) > 1 })
+	if index != 1 || value != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, 1, 3)
+	}
+	index, value = heap.Find(func(index int, value any) bool { return value.(int) > 5 })
+	if index != -1 || value != nil {
+		t.Errorf("Got %v,%v expected %v,%v", index, value, -1, nil)
+	}
+}
+
+func TestIndexedHeapPushPop(t *testing.T) {
+	heap := NewIndexedWithIntComparator()
+	if _, _, ok := heap.Pop(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	heap.Push("c", 3)
+	heap.Push("a", 1)
+	heap.Push("b", 2)
+	heap.Push("d", 0)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if item, priority, ok := heap.Peek(); item != "d" || priority != 0 || !ok {
+		t.Errorf("Got %v %v %v expected %v %v %v", item, priority, ok, "d", 0, true)
+	}
+	if actualValue, expectedValue := heap.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	var popped []any
+	for item, _, ok := heap.Pop(); ok; item, _, ok = heap.Pop() {
+		popped = append(popped, item)
+		if err := heap.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[d a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestIndexedHeapUpdateRemove(t *testing.T) {
+	heap := NewIndexedWithIntComparator()
+	for i := 0; i < 10; i++ {
+		heap.Push(i, i*10)
+	}
+	if ok := heap.Update(7, -1); !ok { // decrease-key
+		t.Errorf("Got %v expected %v", ok, true)
+	}
+	if item, _, _ := heap.Peek(); item != 7 {
+		t.Errorf("Got %v expected %v", item, 7)
+	}
+	if ok := heap.Update(0, 100); !ok { // increase-key
+		t.Errorf("Got %v expected %v", ok, true)
+	}
+	heap.Push(1, 5) // updates the existing item
+	if ok := heap.Update(42, 0); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if priority, ok := heap.Priority(0); priority != 100 || !ok {
+		t.Errorf("Got %v %v expected %v %v", priority, ok, 100, true)
+	}
+	if priority, ok := heap.Remove(4); priority != 40 || !ok {
+		t.Errorf("Got %v %v expected %v %v", priority, ok, 40, true)
+	}
+	if _, ok := heap.Remove(4); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if actualValue := heap.Contains(4); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	var popped []any
+	for item, _, ok := heap.Pop(); ok; item, _, ok = heap.Pop() {
+		popped = append(popped, item)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[7 1 2 3 5 6 8 9 0]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestIndexedHeapFix(t *testing.T) {
+	type distance struct{ value int }
+	heap := NewIndexedWith(func(a, b any) int {
+		return utils.IntComparator(a.(*distance).value, b.(*distance).value)
+	})
+	distances := map[string]*distance{"a": {1}, "b": {2}, "c": {3}}
+	for item, d := range distances {
+		heap.Push(item, d)
+	}
+	distances["c"].value = 0
+	index, ok := heap.Index("c")
+	if !ok {
+		t.Errorf("Got %v expected %v", ok, true)
+	}
+	heap.Fix(index)
+	heap.Fix(-1)
+	heap.Fix(heap.Size())
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if item, _, _ := heap.Peek(); item != "c" {
+		t.Errorf("Got %v expected %v", item, "c")
+	}
+	if _, ok := heap.Index("z"); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestIndexedHeapRandom(t *testing.T) {
+	heap := NewIndexedWithIntComparator()
+	priorities := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		item := rand.Intn(100)
+		switch rand.Intn(3) {
+		case 0:
+			priority := rand.Intn(1000)
+			heap.Push(item, priority)
+			priorities[item] = priority
+		case 1:
+			_, expectedOk := priorities[item]
+			if _, ok := heap.Remove(item); ok != expectedOk {
+				t.Fatalf("Got %v expected %v", ok, expectedOk)
+			}
+			delete(priorities, item)
+		default:
+			item, priority, ok := heap.Pop()
+			if !ok {
+				continue
+			}
+			for _, other := range priorities {
+				if other < priority.(int) {
+					t.Fatalf("Got %v popped before %v", priority, other)
+				}
+			}
+			delete(priorities, item.(int))
+		}
+		if err := heap.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if actualValue, expectedValue := heap.Size(), len(priorities); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	heap.Clear()
+	if actualValue, expectedValue := heap.String(), "IndexedHeap\n"; actualValue != expectedValue {
+		t.Errorf("Got %q expected %q", actualValue, expectedValue)
+	}
+}
+
+func TestIndexedHeapDotString(t *testing.T) {
+	heap := NewIndexedWithIntComparator()
+	heap.Push("b", 2)
+	heap.Push("a|x", 1)
+	dotString := heap.dotString()
+	for _, expected := range []string{"0 -> 1;", `label="a|x: 1\n#0"`, `{a\|x|0}`, `{b|1}`, "shape=record"} {
+		if !strings.Contains(dotString, expected) {
+			t.Errorf("Got %v expected it to contain %v", dotString, expected)
+		}
+	}
+}
+
 func BenchmarkBinaryHeapPop100(b *testing.B) {
 	b.StopTimer()
 	size := 100
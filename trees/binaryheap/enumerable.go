@@ -0,0 +1,76 @@
+package binaryheap
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.EnumerableWithIndex = (*Heap)(nil)
+
+// Each calls the given function once for each element, passing that element's index and value.
+// Elements are visited in the order of the heap's iterator.
+func (heap *Heap) Each(f func(index int, value any)) {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		f(iterator.Index(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a
+// container containing the values returned by the given function.
+// The returned heap uses the same comparator as the heap, so the values are ordered by it rather than by the index.
+func (heap *Heap) Map(f func(index int, value any) any) *Heap {
+	newHeap := NewWith(heap.Comparator)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		newHeap.Push(f(iterator.Index(), iterator.Value()))
+	}
+	return newHeap
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// The returned heap uses the same comparator as the heap.
+func (heap *Heap) Select(f func(index int, value any) bool) *Heap {
+	newHeap := NewWith(heap.Comparator)
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			newHeap.Push(iterator.Value())
+		}
+	}
+	return newHeap
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (heap *Heap) Any(f func(index int, value any) bool) bool {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (heap *Heap) All(f func(index int, value any) bool) bool {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Index(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (index,value) for which the function is true or -1,nil otherwise
+// if no element matches the criteria.
+func (heap *Heap) Find(f func(index int, value any) bool) (int, any) {
+	iterator := heap.Iterator()
+	for iterator.Next() {
+		if f(iterator.Index(), iterator.Value()) {
+			return iterator.Index(), iterator.Value()
+		}
+	}
+	return -1, nil
+}
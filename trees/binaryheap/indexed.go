@@ -0,0 +1,259 @@
+package binaryheap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Arafatk/Dataviz/trees"
+	"github.com/Arafatk/Dataviz/utils"
+)
+
+var _ trees.Tree = (*IndexedHeap)(nil)
+
+// IndexedHeap is a binary heap of items ordered by their priorities, which keeps track of the index of every item within the heap.
+//
+// Items are handles of any comparable type, e.g. the vertices of a graph, and are unique within the heap.
+// Tracking their indices allows changing the priority of an item (decrease-key and increase-key) and removing an arbitrary item
+// in logarithmic time, as needed by Dijkstra's and Prim's algorithms.
+//
+// Comparator compares the priorities and defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+type IndexedHeap struct {
+	entries    []indexedEntry
+	indices    map[any]int
+	Comparator utils.Comparator
+}
+
+type indexedEntry struct {
+	item     any
+	priority any
+}
+
+// NewIndexedWith instantiates a new empty indexed heap with the custom comparator of the priorities.
+func NewIndexedWith(comparator utils.Comparator) *IndexedHeap {
+	return &IndexedHeap{indices: make(map[any]int), Comparator: comparator}
+}
+
+// NewIndexedWithIntComparator instantiates a new empty indexed heap with the IntComparator, i.e. priorities are of type int.
+func NewIndexedWithIntComparator() *IndexedHeap {
+	return NewIndexedWith(utils.IntComparator)
+}
+
+// NewIndexedWithStringComparator instantiates a new empty indexed heap with the StringComparator, i.e. priorities are of type string.
+func NewIndexedWithStringComparator() *IndexedHeap {
+	return NewIndexedWith(utils.StringComparator)
+}
+
+// Push adds the item with the priority onto the heap and bubbles it up accordingly.
+// If the heap already holds the item, its priority is updated instead, see Update.
+func (heap *IndexedHeap) Push(item any, priority any) {
+	if heap.Update(item, priority) {
+		return
+	}
+	heap.indices[item] = len(heap.entries)
+	heap.entries = append(heap.entries, indexedEntry{item: item, priority: priority})
+	heap.bubbleUpIndex(len(heap.entries) - 1)
+}
+
+// Update changes the priority of the item and moves it up or down the heap accordingly,
+// which covers both decrease-key and increase-key.
+// Returns false if the heap does not hold the item.
+func (heap *IndexedHeap) Update(item any, priority any) bool {
+	index, found := heap.indices[item]
+	if !found {
+		return false
+	}
+	heap.entries[index].priority = priority
+	heap.Fix(index)
+	return true
+}
+
+// Remove removes the item from the heap and returns its priority.
+// Second return parameter is true, unless the heap did not hold the item.
+func (heap *IndexedHeap) Remove(item any) (priority any, ok bool) {
+	index, found := heap.indices[item]
+	if !found {
+		return nil, false
+	}
+	return heap.removeIndex(index).priority, true
+}
+
+// Pop removes the top item of the heap and returns it with its priority, or nils if heap is empty.
+// Third return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *IndexedHeap) Pop() (item any, priority any, ok bool) {
+	if len(heap.entries) == 0 {
+		return nil, nil, false
+	}
+	entry := heap.removeIndex(0)
+	return entry.item, entry.priority, true
+}
+
+// Peek returns the top item of the heap with its priority without removing it, or nils if heap is empty.
+// Third return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *IndexedHeap) Peek() (item any, priority any, ok bool) {
+	if len(heap.entries) == 0 {
+		return nil, nil, false
+	}
+	return heap.entries[0].item, heap.entries[0].priority, true
+}
+
+// Fix restores the heap order after the priority of the item at the index was changed in place,
+// e.g. a field of a pointer priority read by the comparator, by moving it up or down the heap accordingly.
+// Does nothing if the index is out of range.
+func (heap *IndexedHeap) Fix(index int) {
+	if !heap.withinRange(index) {
+		return
+	}
+	heap.bubbleUpIndex(index)
+	heap.bubbleDownIndex(index)
+}
+
+// Contains returns true if the heap holds the item.
+func (heap *IndexedHeap) Contains(item any) bool {
+	_, found := heap.indices[item]
+	return found
+}
+
+// Priority returns the priority of the item.
+// Second return parameter is true, unless the heap does not hold the item.
+func (heap *IndexedHeap) Priority(item any) (priority any, ok bool) {
+	index, found := heap.indices[item]
+	if !found {
+		return nil, false
+	}
+	return heap.entries[index].priority, true
+}
+
+// Index returns the index of the item within the heap, for use with Fix.
+// Second return parameter is true, unless the heap does not hold the item.
+func (heap *IndexedHeap) Index(item any) (index int, ok bool) {
+	index, ok = heap.indices[item]
+	return
+}
+
+// Empty returns true if heap does not contain any items.
+func (heap *IndexedHeap) Empty() bool {
+	return len(heap.entries) == 0
+}
+
+// Size returns number of items within the heap.
+func (heap *IndexedHeap) Size() int {
+	return len(heap.entries)
+}
+
+// Clear removes all items from the heap.
+func (heap *IndexedHeap) Clear() {
+	heap.entries = nil
+	heap.indices = make(map[any]int)
+}
+
+// Values returns all items in the heap in the order of their indices.
+func (heap *IndexedHeap) Values() []any {
+	items := make([]any, len(heap.entries))
+	for index, entry := range heap.entries {
+		items[index] = entry.item
+	}
+	return items
+}
+
+// String returns a string representation of container
+func (heap *IndexedHeap) String() string {
+	str := "IndexedHeap\n"
+	values := []string{}
+	for _, entry := range heap.entries {
+		values = append(values, fmt.Sprintf("%v:%v", entry.item, entry.priority))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Validate checks the heap order and the index of every item and returns an error describing the first violation found,
+// or nil if no item is ordered before its parent and every item is found at its tracked index.
+// Validation visits every item, so it is meant for tests and debugging.
+func (heap *IndexedHeap) Validate() error {
+	if len(heap.indices) != len(heap.entries) {
+		return fmt.Errorf("binaryheap: %v indices tracked for %v items", len(heap.indices), len(heap.entries))
+	}
+	for index, entry := range heap.entries {
+		tracked, found := heap.indices[entry.item]
+		if !found {
+			return fmt.Errorf("binaryheap: item %v at index %v is not tracked", entry.item, index)
+		}
+		if tracked != index {
+			return fmt.Errorf("binaryheap: item %v at index %v is tracked at index %v", entry.item, index, tracked)
+		}
+		if index == 0 {
+			continue
+		}
+		parentIndex := (index - 1) >> 1
+		if heap.compare(parentIndex, index) > 0 {
+			return fmt.Errorf("binaryheap: item %v at index %v is ordered before its parent %v at index %v", entry.item, index, heap.entries[parentIndex].item, parentIndex)
+		}
+	}
+	return nil
+}
+
+// Visualizer makes a visual image demonstrating the indexed heap data structure
+// using dot language and Graphviz. The heap is drawn as a tree of its items and their priorities,
+// next to a table of the index map from every item to its index within the heap.
+func (heap *IndexedHeap) Visualizer(fileName string) bool {
+	return utils.WriteDotStringToPng(fileName, heap.dotString())
+}
+
+func (heap *IndexedHeap) dotString() string {
+	dotString := "digraph graphname{bgcolor=white;"
+	rows := []string{"{item|index}"}
+	for index, entry := range heap.entries {
+		if index != 0 {
+			dotString += strconv.Itoa((index-1)/2) + " -> " + strconv.Itoa(index) + ";"
+		}
+		label := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(fmt.Sprintf("%v: %v", entry.item, entry.priority))
+		dotString += strconv.Itoa(index) + "[color=steelblue1, style=filled, fillcolor = steelblue1, fontcolor=white,label=\"" + label + "\\n#" + strconv.Itoa(index) + "\"];"
+		rows = append(rows, fmt.Sprintf("{%v|%v}", escape(fmt.Sprint(entry.item)), index))
+	}
+	dotString += "indices[shape=record, color=gray, label=\"{" + strings.Join(rows, "|") + "}\"];"
+	return dotString + "}"
+}
+
+// removeIndex removes the entry at the index by swapping it with the last one, which is then moved up or down the heap accordingly.
+func (heap *IndexedHeap) removeIndex(index int) indexedEntry {
+	entry := heap.entries[index]
+	lastIndex := len(heap.entries) - 1
+	heap.swap(index, lastIndex)
+	heap.entries[lastIndex] = indexedEntry{}
+	heap.entries = heap.entries[:lastIndex]
+	delete(heap.indices, entry.item)
+	heap.Fix(index)
+	return entry
+}
+
+func (heap *IndexedHeap) bubbleUpIndex(index int) {
+	SiftUp(index, heap.compare, heap.swap)
+}
+
+func (heap *IndexedHeap) bubbleDownIndex(index int) {
+	SiftDown(index, len(heap.entries), heap.compare, heap.swap)
+}
+
+// compare compares the priorities of the items at the indices i and j with the heap's comparator.
+func (heap *IndexedHeap) compare(i, j int) int {
+	return heap.Comparator(heap.entries[i].priority, heap.entries[j].priority)
+}
+
+// swap swaps the items at the indices i and j and updates their tracked indices.
+func (heap *IndexedHeap) swap(i, j int) {
+	heap.entries[i], heap.entries[j] = heap.entries[j], heap.entries[i]
+	heap.indices[heap.entries[i].item] = i
+	heap.indices[heap.entries[j].item] = j
+}
+
+func (heap *IndexedHeap) withinRange(index int) bool {
+	return index >= 0 && index < len(heap.entries)
+}
+
+// escape escapes the characters with a special meaning in record labels.
+func escape(label string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "{", `\{`, "}", `\}`, "|", `\|`, "<", `\<`, ">", `\>`).Replace(label)
+}
@@ -0,0 +1,17 @@
+package binaryheap
+
+import "fmt"
+
+// Validate checks the heap order and returns an error describing the first violation found,
+// or nil if no element is ordered before its parent by the heap's comparator.
+// Validation visits every element, so it is meant for tests and debugging.
+func (heap *Heap) Validate() error {
+	values := heap.list.Values()
+	for index := 1; index < len(values); index++ {
+		parentIndex := (index - 1) >> 1
+		if heap.Comparator(values[parentIndex], values[index]) > 0 {
+			return fmt.Errorf("binaryheap: element %v at index %v is ordered before its parent %v at index %v", values[index], index, values[parentIndex], parentIndex)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,652 @@
+// Package bplustree implements a B+ tree.
+//
+// A B+ tree of order m is a B-tree in which all key-value pairs are stored in the leaves,
+// while internal nodes only hold routing keys:
+// - Every node has at most m children.
+// - Every non-leaf node (except root) has at least ⌈m/2⌉ children.
+// - The root has at least two children if it is not a leaf node.
+// - A non-leaf node with k children contains k−1 routing keys.
+// - All leaves appear in the same level and are linked to their siblings, which makes range scans cheap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/B%2B_tree
+package bplustree
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Arafatk/Dataviz/trees"
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+var _ trees.Tree = (*Tree)(nil)
+
+// Tree holds elements of the B+ tree
+type Tree struct {
+	Root       *Node             // Root node
+	Comparator utils.Comparator  // Key comparator
+	KeyCodec   keycodec.KeyCodec // Key codec of the JSON representation, nil for a JSON object with string keys
+	size       int               // Total number of keys in the tree
+	m          int               // order (maximum number of children)
+}
+
+// Node is a single element within the tree.
+// Internal nodes hold routing keys and children, leaves hold entries and links to their siblings.
+type Node struct {
+	Parent   *Node
+	Keys     []any    // Routing keys of an internal node
+	Children []*Node  // Children nodes of an internal node
+	Entries  []*Entry // Contained key-value pairs of a leaf
+	Prev     *Node    // Left sibling of a leaf
+	Next     *Node    // Right sibling of a leaf
+}
+
+// Entry represents the key-value pair contained within leaves
+type Entry struct {
+	Key   any
+	Value any
+}
+
+// NewWith instantiates a B+ tree with the order (maximum number of children) and a custom key comparator.
+func NewWith(order int, comparator utils.Comparator) *Tree {
+	if order < 3 {
+		panic("Invalid order, should be at least 3")
+	}
+	return &Tree{m: order, Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates a B+ tree with the order (maximum number of children) and the IntComparator, i.e. keys are of type int.
+func NewWithIntComparator(order int) *Tree {
+	return NewWith(order, utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a B+ tree with the order (maximum number of children) and the StringComparator, i.e. keys are of type string.
+func NewWithStringComparator(order int) *Tree {
+	return NewWith(order, utils.StringComparator)
+}
+
+// Put inserts key-value pair into the tree.
+// If key already exists, then its value is updated with the new value.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Put(key any, value any) {
+	entry := &Entry{Key: key, Value: value}
+
+	if tree.Root == nil {
+		tree.Root = &Node{Entries: []*Entry{entry}}
+		tree.size++
+		return
+	}
+
+	leaf := tree.findLeaf(key)
+	index, found := tree.search(leaf, key)
+	if found {
+		leaf.Entries[index] = entry
+		return
+	}
+	leaf.Entries = append(leaf.Entries, nil)
+	copy(leaf.Entries[index+1:], leaf.Entries[index:])
+	leaf.Entries[index] = entry
+	tree.size++
+	tree.splitLeaf(leaf)
+}
+
+// Get searches the entry in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Get(key any) (value any, found bool) {
+	if tree.Empty() {
+		return nil, false
+	}
+	leaf := tree.findLeaf(key)
+	if index, found := tree.search(leaf, key); found {
+		return leaf.Entries[index].Value, true
+	}
+	return nil, false
+}
+
+// Remove removes the entry from the tree by key.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Remove(key any) {
+	if tree.Empty() {
+		return
+	}
+	leaf := tree.findLeaf(key)
+	index, found := tree.search(leaf, key)
+	if !found {
+		return
+	}
+	copy(leaf.Entries[index:], leaf.Entries[index+1:])
+	leaf.Entries[len(leaf.Entries)-1] = nil
+	leaf.Entries = leaf.Entries[:len(leaf.Entries)-1]
+	tree.size--
+	tree.rebalanceLeaf(leaf)
+}
+
+// Empty returns true if tree does not contain any entries
+func (tree *Tree) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of entries in the tree.
+func (tree *Tree) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in-order.
+func (tree *Tree) Keys() []any {
+	keys := make([]any, 0, tree.size)
+	for leaf := tree.Left(); leaf != nil; leaf = leaf.Next {
+		for _, entry := range leaf.Entries {
+			keys = append(keys, entry.Key)
+		}
+	}
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *Tree) Values() []any {
+	values := make([]any, 0, tree.size)
+	for leaf := tree.Left(); leaf != nil; leaf = leaf.Next {
+		for _, entry := range leaf.Entries {
+			values = append(values, entry.Value)
+		}
+	}
+	return values
+}
+
+// Clear removes all entries from the tree.
+func (tree *Tree) Clear() {
+	tree.Root = nil
+	tree.size = 0
+}
+
+// Height returns the height of the tree.
+func (tree *Tree) Height() int {
+	height := 0
+	for node := tree.Root; node != nil; height++ {
+		if node.isLeaf() {
+			return height + 1
+		}
+		node = node.Children[0]
+	}
+	return height
+}
+
+// Left returns the left-most leaf or nil if tree is empty.
+func (tree *Tree) Left() *Node {
+	if tree.Empty() {
+		return nil
+	}
+	node := tree.Root
+	for !node.isLeaf() {
+		node = node.Children[0]
+	}
+	return node
+}
+
+// LeftKey returns the left-most (min) key or nil if tree is empty.
+func (tree *Tree) LeftKey() any {
+	if left := tree.Left(); left != nil {
+		return left.Entries[0].Key
+	}
+	return nil
+}
+
+// Right returns the right-most leaf or nil if tree is empty.
+func (tree *Tree) Right() *Node {
+	if tree.Empty() {
+		return nil
+	}
+	node := tree.Root
+	for !node.isLeaf() {
+		node = node.Children[len(node.Children)-1]
+	}
+	return node
+}
+
+// RightKey returns the right-most (max) key or nil if tree is empty.
+func (tree *Tree) RightKey() any {
+	if right := tree.Right(); right != nil {
+		return right.Entries[len(right.Entries)-1].Key
+	}
+	return nil
+}
+
+// BulkLoad replaces the contents of the tree with the given key-value pairs, building the tree bottom-up.
+// Keys must be sorted in strictly ascending order with respect to the tree's comparator and
+// there must be as many values as keys, otherwise an error is returned and the tree is left unchanged.
+//
+// Leaves are packed as full as possible, so loading n sorted entries takes O(n) time
+// instead of the O(n log n) required by repeated calls to Put.
+func (tree *Tree) BulkLoad(keys []any, values []any) error {
+	if len(keys) != len(values) {
+		return errors.New("bplustree: number of keys and values differ")
+	}
+	for i := 1; i < len(keys); i++ {
+		if tree.Comparator(keys[i-1], keys[i]) >= 0 {
+			return errors.New("bplustree: keys are not sorted in strictly ascending order")
+		}
+	}
+
+	tree.Clear()
+	if len(keys) == 0 {
+		return nil
+	}
+	size := len(keys)
+
+	// pack the entries into a chain of leaves
+	level := []*Node{}
+	var prev *Node
+	for _, count := range distribute(len(keys), tree.maxEntries()) {
+		leaf := &Node{Entries: make([]*Entry, count), Prev: prev}
+		for i := range leaf.Entries {
+			leaf.Entries[i] = &Entry{Key: keys[0], Value: values[0]}
+			keys, values = keys[1:], values[1:]
+		}
+		if prev != nil {
+			prev.Next = leaf
+		}
+		prev = leaf
+		level = append(level, leaf)
+	}
+
+	// build the internal levels on top of the leaves until a single root remains
+	for len(level) > 1 {
+		parents := []*Node{}
+		for _, count := range distribute(len(level), tree.maxChildren()) {
+			parent := &Node{Children: append([]*Node(nil), level[:count]...)}
+			for i, child := range parent.Children {
+				child.Parent = parent
+				if i > 0 {
+					parent.Keys = append(parent.Keys, child.minKey())
+				}
+			}
+			level = level[count:]
+			parents = append(parents, parent)
+		}
+		level = parents
+	}
+
+	tree.Root = level[0]
+	tree.size = size
+	return nil
+}
+
+// String returns a string representation of container (for debugging purposes)
+func (tree *Tree) String() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("BPlusTree\n")
+	if !tree.Empty() {
+		tree.output(&buffer, tree.Root, 0)
+	}
+	return buffer.String()
+}
+
+func (entry *Entry) String() string {
+	return fmt.Sprintf("%v", entry.Key)
+}
+
+func (tree *Tree) output(buffer *bytes.Buffer, node *Node, level int) {
+	if node.isLeaf() {
+		for _, entry := range node.Entries {
+			buffer.WriteString(strings.Repeat("    ", level) + entry.String() + "\n")
+		}
+		return
+	}
+	for i, child := range node.Children {
+		tree.output(buffer, child, level+1)
+		if i < len(node.Keys) {
+			buffer.WriteString(strings.Repeat("    ", level) + fmt.Sprintf("%v", node.Keys[i]) + "\n")
+		}
+	}
+}
+
+// Visualizer makes a visual image demonstrating the B+ tree data structure
+// using dot language and Graphviz. Internal routing nodes and leaves are drawn as clusters
+// and the leaf chain is drawn with dashed edges. It first produces a dot string corresponding
+// to the B+ tree and then runs graphviz to output the resulting image to a file.
+func (tree *Tree) Visualizer(fileName string) bool {
+	dotString := "digraph G{bgcolor=azure;"
+	nodeIndexCount := 0
+	subGraphNumber := 0
+	firstIndex := make(map[*Node]int) // index of the first dot node of every tree node
+	lastIndex := make(map[*Node]int)  // index of the last dot node of every tree node
+	level := []*Node{}
+	if tree.Root != nil {
+		level = append(level, tree.Root)
+	}
+	for len(level) > 0 {
+		next := []*Node{}
+		for _, node := range level {
+			firstIndex[node] = nodeIndexCount
+			if node.isLeaf() {
+				dotString += "subgraph cluster_" + strconv.Itoa(subGraphNumber) + "{style=filled;color=plum;node [style=filled,color=white, shape=\"Msquare\"];"
+				for _, entry := range node.Entries {
+					dotString += strconv.Itoa(nodeIndexCount) + "[fontcolor=blueviolet;label=\"" + fmt.Sprintf("%v", entry.Key) + "->" + fmt.Sprintf("%v", entry.Value) + "\"];"
+					nodeIndexCount++
+				}
+			} else {
+				dotString += "subgraph cluster_" + strconv.Itoa(subGraphNumber) + "{style=filled;color=lightblue;node [style=filled,color=white, shape=\"box\"];"
+				for _, key := range node.Keys {
+					dotString += strconv.Itoa(nodeIndexCount) + "[fontcolor=navy;label=\"" + fmt.Sprintf("%v", key) + "\"];"
+					nodeIndexCount++
+				}
+				next = append(next, node.Children...)
+			}
+			lastIndex[node] = nodeIndexCount - 1
+			subGraphNumber++
+			dotString += "};"
+		}
+		level = next
+	}
+	for node, index := range firstIndex {
+		if node.isLeaf() {
+			if node.Next != nil { // Leaf chain
+				dotString += strconv.Itoa(lastIndex[node]) + "->" + strconv.Itoa(firstIndex[node.Next]) + "[style=dashed,color=gray,constraint=false];"
+			}
+			continue
+		}
+		for i, child := range node.Children { // Left of every routing key and right of the last one
+			keyIndex := index + min(i, len(node.Keys)-1)
+			dotString += strconv.Itoa(keyIndex) + "->" + strconv.Itoa(firstIndex[child]) + ";"
+		}
+	}
+	dotString += "}"
+
+	return utils.WriteDotStringToPng(fileName, dotString)
+}
+
+// distribute splits count items into the fewest groups of at most max items, spreading items evenly among the groups.
+func distribute(count int, max int) []int {
+	groups := (count + max - 1) / max
+	sizes := make([]int, groups)
+	for i := range sizes {
+		sizes[i] = count / groups
+		if i < count%groups {
+			sizes[i]++
+		}
+	}
+	return sizes
+}
+
+func (node *Node) isLeaf() bool {
+	return len(node.Children) == 0
+}
+
+// minKey returns the smallest key stored within the subtree rooted at node
+func (node *Node) minKey() any {
+	for !node.isLeaf() {
+		node = node.Children[0]
+	}
+	return node.Entries[0].Key
+}
+
+func (tree *Tree) maxChildren() int {
+	return tree.m
+}
+
+func (tree *Tree) minChildren() int {
+	return (tree.m + 1) / 2 // ceil(m/2)
+}
+
+func (tree *Tree) maxEntries() int {
+	return tree.maxChildren() - 1
+}
+
+func (tree *Tree) minEntries() int {
+	return tree.minChildren() - 1
+}
+
+// search searches only within the single leaf among its entries
+func (tree *Tree) search(leaf *Node, key any) (index int, found bool) {
+	low, high := 0, len(leaf.Entries)-1
+	var mid int
+	for low <= high {
+		mid = (high + low) / 2
+		compare := tree.Comparator(key, leaf.Entries[mid].Key)
+		switch {
+		case compare > 0:
+			low = mid + 1
+		case compare < 0:
+			high = mid - 1
+		case compare == 0:
+			return mid, true
+		}
+	}
+	return low, false
+}
+
+// route returns the index of the child of an internal node whose subtree may contain the key
+func (tree *Tree) route(node *Node, key any) int {
+	low, high := 0, len(node.Keys)
+	for low < high {
+		mid := (high + low) / 2
+		if tree.Comparator(key, node.Keys[mid]) < 0 {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low
+}
+
+// findLeaf descends from the root to the leaf whose key range contains the key
+func (tree *Tree) findLeaf(key any) *Node {
+	node := tree.Root
+	for !node.isLeaf() {
+		node = node.Children[tree.route(node, key)]
+	}
+	return node
+}
+
+// childIndex returns the position of node among its parent's children
+func childIndex(node *Node) int {
+	for i, child := range node.Parent.Children {
+		if child == node {
+			return i
+		}
+	}
+	return -1
+}
+
+func (tree *Tree) splitLeaf(leaf *Node) {
+	if len(leaf.Entries) <= tree.maxEntries() {
+		return
+	}
+	middle := len(leaf.Entries) / 2
+	right := &Node{Entries: append([]*Entry(nil), leaf.Entries[middle:]...), Prev: leaf, Next: leaf.Next}
+	leaf.Entries = append([]*Entry(nil), leaf.Entries[:middle]...)
+	if leaf.Next != nil {
+		leaf.Next.Prev = right
+	}
+	leaf.Next = right
+	tree.insertIntoParent(leaf, right.Entries[0].Key, right)
+}
+
+func (tree *Tree) splitInternal(node *Node) {
+	if len(node.Children) <= tree.maxChildren() {
+		return
+	}
+	middle := len(node.Keys) / 2
+	separator := node.Keys[middle]
+	right := &Node{
+		Keys:     append([]any(nil), node.Keys[middle+1:]...),
+		Children: append([]*Node(nil), node.Children[middle+1:]...),
+	}
+	node.Keys = append([]any(nil), node.Keys[:middle]...)
+	node.Children = append([]*Node(nil), node.Children[:middle+1]...)
+	setParent(right.Children, right)
+	tree.insertIntoParent(node, separator, right)
+}
+
+// insertIntoParent links the new right node next to left within their parent, splitting the parent if necessary
+func (tree *Tree) insertIntoParent(left *Node, separator any, right *Node) {
+	parent := left.Parent
+	if parent == nil {
+		tree.Root = &Node{Keys: []any{separator}, Children: []*Node{left, right}}
+		left.Parent = tree.Root
+		right.Parent = tree.Root
+		return
+	}
+	index := childIndex(left)
+	parent.Keys = append(parent.Keys, nil)
+	copy(parent.Keys[index+1:], parent.Keys[index:])
+	parent.Keys[index] = separator
+	parent.Children = append(parent.Children, nil)
+	copy(parent.Children[index+2:], parent.Children[index+1:])
+	parent.Children[index+1] = right
+	right.Parent = parent
+	tree.splitInternal(parent)
+}
+
+func setParent(nodes []*Node, parent *Node) {
+	for _, node := range nodes {
+		node.Parent = parent
+	}
+}
+
+// rebalanceLeaf restores the minimum occupancy of a leaf after an entry was removed from it
+func (tree *Tree) rebalanceLeaf(leaf *Node) {
+	if leaf == tree.Root {
+		if len(leaf.Entries) == 0 {
+			tree.Root = nil
+		}
+		return
+	}
+	if len(leaf.Entries) >= tree.minEntries() {
+		return
+	}
+
+	parent := leaf.Parent
+	index := childIndex(leaf)
+	var left, right *Node
+	if index > 0 {
+		left = parent.Children[index-1]
+	}
+	if index+1 < len(parent.Children) {
+		right = parent.Children[index+1]
+	}
+
+	// try to borrow from left sibling
+	if left != nil && len(left.Entries) > tree.minEntries() {
+		borrowed := left.Entries[len(left.Entries)-1]
+		left.Entries = left.Entries[:len(left.Entries)-1]
+		leaf.Entries = append([]*Entry{borrowed}, leaf.Entries...)
+		parent.Keys[index-1] = borrowed.Key
+		return
+	}
+
+	// try to borrow from right sibling
+	if right != nil && len(right.Entries) > tree.minEntries() {
+		borrowed := right.Entries[0]
+		right.Entries = append([]*Entry(nil), right.Entries[1:]...)
+		leaf.Entries = append(leaf.Entries, borrowed)
+		parent.Keys[index] = right.Entries[0].Key
+		return
+	}
+
+	// merge with siblings
+	if left != nil {
+		left.Entries = append(left.Entries, leaf.Entries...)
+		tree.unlinkLeaf(leaf)
+		tree.removeChild(parent, index)
+	} else if right != nil {
+		leaf.Entries = append(leaf.Entries, right.Entries...)
+		tree.unlinkLeaf(right)
+		tree.removeChild(parent, index+1)
+	}
+	tree.rebalanceInternal(parent)
+}
+
+// rebalanceInternal restores the minimum occupancy of an internal node after a child was removed from it
+func (tree *Tree) rebalanceInternal(node *Node) {
+	if node == tree.Root {
+		if len(node.Children) == 1 {
+			tree.Root = node.Children[0]
+			tree.Root.Parent = nil
+		}
+		return
+	}
+	if len(node.Children) >= tree.minChildren() {
+		return
+	}
+
+	parent := node.Parent
+	index := childIndex(node)
+	var left, right *Node
+	if index > 0 {
+		left = parent.Children[index-1]
+	}
+	if index+1 < len(parent.Children) {
+		right = parent.Children[index+1]
+	}
+
+	// try to borrow from left sibling (rotate right through the parent)
+	if left != nil && len(left.Children) > tree.minChildren() {
+		child := left.Children[len(left.Children)-1]
+		node.Keys = append([]any{parent.Keys[index-1]}, node.Keys...)
+		node.Children = append([]*Node{child}, node.Children...)
+		child.Parent = node
+		parent.Keys[index-1] = left.Keys[len(left.Keys)-1]
+		left.Keys = left.Keys[:len(left.Keys)-1]
+		left.Children = left.Children[:len(left.Children)-1]
+		return
+	}
+
+	// try to borrow from right sibling (rotate left through the parent)
+	if right != nil && len(right.Children) > tree.minChildren() {
+		child := right.Children[0]
+		node.Keys = append(node.Keys, parent.Keys[index])
+		node.Children = append(node.Children, child)
+		child.Parent = node
+		parent.Keys[index] = right.Keys[0]
+		right.Keys = append([]any(nil), right.Keys[1:]...)
+		right.Children = append([]*Node(nil), right.Children[1:]...)
+		return
+	}
+
+	// merge with siblings, pulling the separating key down from the parent
+	if left != nil {
+		left.Keys = append(append(left.Keys, parent.Keys[index-1]), node.Keys...)
+		left.Children = append(left.Children, node.Children...)
+		setParent(node.Children, left)
+		tree.removeChild(parent, index)
+	} else if right != nil {
+		node.Keys = append(append(node.Keys, parent.Keys[index]), right.Keys...)
+		node.Children = append(node.Children, right.Children...)
+		setParent(right.Children, node)
+		tree.removeChild(parent, index+1)
+	}
+	tree.rebalanceInternal(parent)
+}
+
+// removeChild removes the child at index from an internal node together with the routing key on its left
+// (or on its right in case of the first child).
+func (tree *Tree) removeChild(node *Node, index int) {
+	keyIndex := index - 1
+	if keyIndex < 0 {
+		keyIndex = 0
+	}
+	copy(node.Keys[keyIndex:], node.Keys[keyIndex+1:])
+	node.Keys[len(node.Keys)-1] = nil
+	node.Keys = node.Keys[:len(node.Keys)-1]
+	copy(node.Children[index:], node.Children[index+1:])
+	node.Children[len(node.Children)-1] = nil
+	node.Children = node.Children[:len(node.Children)-1]
+}
+
+func (tree *Tree) unlinkLeaf(leaf *Node) {
+	if leaf.Prev != nil {
+		leaf.Prev.Next = leaf.Next
+	}
+	if leaf.Next != nil {
+		leaf.Next.Prev = leaf.Prev
+	}
+	leaf.Prev, leaf.Next = nil, nil
+}
@@ -0,0 +1,650 @@
+package bplustree
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+func TestBPlusTreePutAndGet(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	tree.Put(7, "g")
+	tree.Put(9, "i")
+	tree.Put(10, "j")
+	tree.Put(6, "f")
+	tree.Put(3, "c")
+	tree.Put(4, "d")
+	tree.Put(5, "e")
+	tree.Put(8, "h")
+	tree.Put(2, "b")
+	tree.Put(1, "x")
+	tree.Put(1, "a") //overwrite
+	assertValidTree(t, tree, 10)
+
+	tests := [][]any{
+		{0, nil, false},
+		{1, "a", true},
+		{2, "b", true},
+		{3, "c", true},
+		{4, "d", true},
+		{5, "e", true},
+		{6, "f", true},
+		{7, "g", true},
+		{8, "h", true},
+		{9, "i", true},
+		{10, "j", true},
+		{11, nil, false},
+	}
+	for _, test := range tests {
+		if value, found := tree.Get(test[0]); value != test[1] || found != test[2] {
+			t.Errorf("Got %v,%v expected %v,%v", value, found, test[1], test[2])
+		}
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[1 2 3 4 5 6 7 8 9 10]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Values()), "[a b c d e f g h i j]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Height(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.LeftKey(), 1; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.RightKey(), 10; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeRemove(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 1; i <= 10; i++ {
+		tree.Put(i, i)
+	}
+	for _, key := range []int{5, 1, 10, 11, 7, 5, 2} {
+		tree.Remove(key)
+		assertValidTree(t, tree, tree.Size())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), "[3 4 6 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, key := range []int{3, 4, 6, 8, 9} {
+		tree.Remove(key)
+		assertValidTree(t, tree, tree.Size())
+	}
+	if actualValue, expectedValue := tree.Empty(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := tree.Height(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeRandomOperations(t *testing.T) {
+	for _, order := range []int{3, 4, 5, 8} {
+		tree := NewWithIntComparator(order)
+		expected := make(map[int]int)
+		random := rand.New(rand.NewSource(int64(order)))
+		for i := 0; i < 2000; i++ {
+			key := random.Intn(300)
+			if random.Intn(3) == 0 {
+				tree.Remove(key)
+				delete(expected, key)
+			} else {
+				tree.Put(key, i)
+				expected[key] = i
+			}
+		}
+		assertValidTree(t, tree, len(expected))
+		for key, value := range expected {
+			if actualValue, found := tree.Get(key); actualValue != value || !found {
+				t.Errorf("order %v: got %v,%v expected %v,%v", order, actualValue, found, value, true)
+			}
+		}
+	}
+}
+
+func TestBPlusTreeBulkLoad(t *testing.T) {
+	for _, order := range []int{3, 4, 7} {
+		for _, size := range []int{0, 1, 2, 5, 17, 100} {
+			keys := make([]any, size)
+			values := make([]any, size)
+			for i := range keys {
+				keys[i], values[i] = i*2, fmt.Sprintf("v%d", i)
+			}
+			tree := NewWithIntComparator(order)
+			tree.Put(-1, "replaced")
+			if err := tree.BulkLoad(keys, values); err != nil {
+				t.Errorf("Got error %v", err)
+			}
+			assertValidTree(t, tree, size)
+			if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+
+			// the tree remains fully functional after bulk loading
+			tree.Put(3, "x")
+			tree.Remove(0)
+			assertValidTree(t, tree, size+1-min(size, 1))
+		}
+	}
+
+	tree := NewWithIntComparator(3)
+	if err := tree.BulkLoad([]any{1, 3, 2}, []any{1, 3, 2}); err == nil {
+		t.Errorf("Expected error for unsorted keys")
+	}
+	if err := tree.BulkLoad([]any{1, 1}, []any{1, 1}); err == nil {
+		t.Errorf("Expected error for duplicate keys")
+	}
+	if err := tree.BulkLoad([]any{1, 2}, []any{1}); err == nil {
+		t.Errorf("Expected error for mismatched values")
+	}
+}
+
+func TestBPlusTreeIterator(t *testing.T) {
+	tree := NewWithIntComparator(4)
+	it := tree.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+
+	for i := 20; i >= 1; i-- {
+		tree.Put(i, i*10)
+	}
+	it = tree.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		if key, value := it.Key(), it.Value(); key != count || value != count*10 {
+			t.Errorf("Got %v,%v expected %v,%v", key, value, count, count*10)
+		}
+	}
+	for it.Prev() {
+		if key := it.Key(); key != count {
+			t.Errorf("Got %v expected %v", key, count)
+		}
+		count--
+	}
+	if actualValue, expectedValue := count, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue || it.Key() != 20 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 20)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue || it.Key() != 1 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 1)
+	}
+}
+
+func TestBPlusTreeIteratorSeek(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 2; i <= 40; i += 2 {
+		tree.Put(i, i)
+	}
+	it := tree.Iterator()
+
+	// key, expectedFound, expectedKey
+	tests := [][]any{
+		{0, true, 2},
+		{13, true, 14},
+		{40, true, 40},
+		{41, false, nil},
+	}
+	for _, test := range tests {
+		found := it.Seek(test[0])
+		if found != test[1] || (found && it.Key() != test[2]) {
+			t.Errorf("Seek(%v): got %v expected %v,%v", test[0], found, test[1], test[2])
+		}
+	}
+	tests = [][]any{
+		{1, false, nil},
+		{2, true, 2},
+		{13, true, 12},
+		{100, true, 40},
+	}
+	for _, test := range tests {
+		found := it.SeekReverse(test[0])
+		if found != test[1] || (found && it.Key() != test[2]) {
+			t.Errorf("SeekReverse(%v): got %v expected %v,%v", test[0], found, test[1], test[2])
+		}
+	}
+}
+
+func TestBPlusTreeRangeIterator(t *testing.T) {
+	tree := NewWithIntComparator(4)
+	for i := 1; i <= 30; i++ {
+		tree.Put(i, i)
+	}
+
+	tests := []struct {
+		from, to any
+		expected string
+	}{
+		{10, 15, "[10 11 12 13 14]"},
+		{nil, 4, "[1 2 3]"},
+		{27, nil, "[27 28 29 30]"},
+		{15, 15, "[]"},
+		{40, 50, "[]"},
+	}
+	for _, test := range tests {
+		it := tree.RangeIterator(test.from, test.to)
+		keys := []any{}
+		for it.Next() {
+			keys = append(keys, it.Key())
+		}
+		if actualValue := fmt.Sprintf("%v", keys); actualValue != test.expected {
+			t.Errorf("[%v,%v) next: got %v expected %v", test.from, test.to, actualValue, test.expected)
+		}
+		reversed := []any{}
+		for it.Prev() {
+			reversed = append([]any{it.Key()}, reversed...)
+		}
+		if actualValue := fmt.Sprintf("%v", reversed); actualValue != test.expected {
+			t.Errorf("[%v,%v) prev: got %v expected %v", test.from, test.to, actualValue, test.expected)
+		}
+	}
+
+	// bounds between the keys, walking across the linked leaves
+	even := NewWithIntComparator(3)
+	for i := 2; i <= 40; i += 2 {
+		even.Put(i, i)
+	}
+	it := even.RangeIterator(5, 31)
+	keys := []any{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprint(keys), "[6 8 10 12 14 16 18 20 22 24 26 28 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !it.Last() || it.Key() != 30 {
+		t.Errorf("Got %v expected %v", it.Key(), 30)
+	}
+	if !it.Seek(1) || it.Key() != 6 {
+		t.Errorf("Got %v expected %v", it.Key(), 6)
+	}
+	if !it.Seek(17) || it.Key() != 18 {
+		t.Errorf("Got %v expected %v", it.Key(), 18)
+	}
+	if it.Seek(31) {
+		t.Errorf("Got %v expected the end of the range", it.Key())
+	}
+	if it := NewWithIntComparator(3).RangeIterator(1, 2); it.Next() || it.Last() {
+		t.Errorf("Expected an empty range")
+	}
+}
+
+func TestBPlusTreeSerialization(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	tree.Put("c", "3")
+	tree.Put("b", "2")
+	tree.Put("a", "1")
+
+	var err error
+	assert := func() {
+		if actualValue, expectedValue := tree.Size(), 3; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue := tree.Keys(); actualValue[0].(string) != "a" || actualValue[1].(string) != "b" || actualValue[2].(string) != "c" {
+			t.Errorf("Got %v expected %v", actualValue, "[a,b,c]")
+		}
+		if actualValue := tree.Values(); actualValue[0].(string) != "1" || actualValue[1].(string) != "2" || actualValue[2].(string) != "3" {
+			t.Errorf("Got %v expected %v", actualValue, "[1,2,3]")
+		}
+		if err != nil {
+			t.Errorf("Got error %v", err)
+		}
+	}
+
+	assert()
+
+	json, err := tree.ToJSON()
+	assert()
+
+	err = tree.FromJSON(json)
+	assert()
+}
+
+func TestBPlusTreeKeyCodec(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+
+	tree.KeyCodec = keycodec.JSON[int]{}
+	json, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `[{"key":1,"value":"a"},{"key":2,"value":"b"},{"key":3,"value":"c"}]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := NewWithIntComparator(3)
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[1 2 3][a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// typed keys are restored without a codec
+	tree.KeyCodec = keycodec.Typed
+	if json, err = tree.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded = NewWithIntComparator(3)
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := loaded.Get(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// unordered pairs, later keys replacing earlier ones
+	if err := loaded.FromJSON([]byte(`[{"key":{"type":"int","value":2},"value":"x"},{"key":{"type":"int","value":1},"value":"y"},{"key":{"type":"int","value":2},"value":"z"}]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[1 2][y z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if err := loaded.FromJSON([]byte(`[{"value":1}]`)); err == nil {
+		t.Errorf("Expected error for a pair without a key")
+	}
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.FromJSON([]byte(`[{"key":"a","value":1}]`)); err == nil {
+		t.Errorf("Expected error for a key of the wrong type")
+	}
+
+	// without a codec the keys are restored as strings, which the IntComparator cannot compare
+	tree.KeyCodec = nil
+	if json, err = tree.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded = NewWithIntComparator(3)
+	loaded.Put(5, "e")
+	if err := loaded.FromJSON(json); err == nil {
+		t.Errorf("Expected error for string keys of a tree with the IntComparator")
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[5][e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded = NewWithIntComparator(3)
+	if err := loaded.FromJSON([]byte(`{"1":"a"}`)); err == nil || !loaded.Empty() {
+		t.Errorf("Expected error for a string key of a tree with the IntComparator")
+	}
+}
+
+func TestBPlusTreeBinary(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for _, i := range rand.Perm(100) {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator(3)
+	loaded.Put(-1, "replaced")
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Keys(), loaded.Values()), fmt.Sprint(tree.Keys(), tree.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Expected error for truncated data")
+	}
+	if err := (&Tree{}).UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for a tree without a comparator")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(tree); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := NewWithIntComparator(3)
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Keys()), fmt.Sprint(tree.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBPlusTreeWriteReadJSON(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 12; i >= 8; i-- {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	var buffer bytes.Buffer
+	if err := tree.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := buffer.String(), `{"8":"8","9":"9","10":"10","11":"11","12":"12"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	json, err := tree.ToJSON()
+	if actualValue, expectedValue := string(json), buffer.String(); actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.KeyCodec = keycodec.JSON[int]{}
+	buffer.Reset()
+	if err := tree.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator(3)
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Keys(), loaded.Values()), fmt.Sprint(tree.Keys(), tree.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.ReadJSON(strings.NewReader(`{"a":1`)); err == nil {
+		t.Errorf("Expected error for truncated input")
+	}
+}
+
+func TestBPlusTreeMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		Tree *Tree
+	}
+	tree := NewWithStringComparator(3)
+	tree.Put("c", 3.0)
+	tree.Put("a", 1.0)
+	tree.Put("b", 2.0)
+	data, err := json.Marshal(document{Name: "letters", Tree: tree})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Tree":{"a":1,"b":2,"c":3}}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	decoded := document{Tree: NewWithStringComparator(3)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := decoded.Name, "letters"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Tree.Keys(), decoded.Tree.Values()), "[a b c] [1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a tree without a comparator")
+	}
+}
+
+func TestBPlusTreeValidate(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	for i := 1; i <= 20; i++ {
+		tree.Put(i, i)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("Got %v expected nil", err)
+		}
+	}
+
+	leaf := tree.Right()
+	leaf.Entries = append(leaf.Entries, &Entry{21, 21}, &Entry{22, 22})
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "at most") {
+		t.Errorf("Got %v expected an overflow violation", err)
+	}
+	leaf.Entries = leaf.Entries[:len(leaf.Entries)-2]
+
+	key := leaf.Entries[0].Key
+	leaf.Entries[0].Key = 0
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "routing range") {
+		t.Errorf("Got %v expected a routing violation", err)
+	}
+	leaf.Entries[0].Key = key
+
+	prev := leaf.Prev
+	leaf.Prev = nil
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "linked") {
+		t.Errorf("Got %v expected a leaf chain violation", err)
+	}
+	leaf.Prev = prev
+
+	parent := leaf.Parent
+	leaf.Parent = nil
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "parent") {
+		t.Errorf("Got %v expected a parent violation", err)
+	}
+	leaf.Parent = parent
+
+	tree.size++
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "size") {
+		t.Errorf("Got %v expected a size violation", err)
+	}
+	tree.size--
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+}
+
+func assertValidTree(t *testing.T, tree *Tree, expectedSize int) {
+	t.Helper()
+	if actualValue, expectedValue := tree.Size(), expectedSize; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v for tree size", actualValue, expectedValue)
+	}
+	if err := tree.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBPlusTreeSeq(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 5; i > 0; i-- {
+		tree.Put(i, fmt.Sprint(i*10))
+	}
+	actual := []string{}
+	for key, value := range tree.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[1:10 2:20 3:30 4:40 5:50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(tree.SeqKeys())), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(tree.SeqValues())), "[10 20 30 40 50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for key, value := range tree.Backward() {
+		if key == 2 {
+			break
+		}
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[5:50 4:40 3:30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzBPlusTree(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckMap(NewWithIntComparator(3), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func benchmarkGet(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			tree.Put(n, struct{}{})
+		}
+	}
+}
+
+func benchmarkRange(b *testing.B, tree *Tree, size int) {
+	for i := 0; i < b.N; i++ {
+		it := tree.RangeIterator(size/4, size/2)
+		for it.Next() {
+		}
+	}
+}
+
+func BenchmarkBPlusTreeGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := NewWithIntComparator(128)
+	for n := 0; n < size; n++ {
+		tree.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkGet(b, tree, size)
+}
+
+func BenchmarkBPlusTreePut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := NewWithIntComparator(128)
+	b.StartTimer()
+	benchmarkPut(b, tree, size)
+}
+
+func BenchmarkBPlusTreeRange100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := NewWithIntComparator(128)
+	for n := 0; n < size; n++ {
+		tree.Put(n, struct{}{})
+	}
+	b.StartTimer()
+	benchmarkRange(b, tree, size)
+}
@@ -0,0 +1,352 @@
+package bplustree
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
+var _ containers.ReverseIteratorWithKey = (*RangeIterator)(nil)
+
+// Iterator holding the iterator's state
+type Iterator struct {
+	tree     *Tree
+	leaf     *Node
+	index    int
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator walks the chain of linked leaves, so moving to the next or previous element takes constant time.
+func (tree *Tree) Iterator() Iterator {
+	return Iterator{tree: tree, leaf: nil, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	switch iterator.position {
+	case begin:
+		iterator.leaf, iterator.index = iterator.tree.Left(), 0
+	case between:
+		iterator.index++
+		if iterator.index >= len(iterator.leaf.Entries) {
+			iterator.leaf, iterator.index = iterator.leaf.Next, 0
+		}
+	case end:
+		iterator.leaf = nil
+	}
+	if iterator.leaf == nil {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	switch iterator.position {
+	case begin:
+		iterator.leaf = nil
+	case between:
+		iterator.index--
+		if iterator.index < 0 {
+			iterator.leaf = iterator.leaf.Prev
+			if iterator.leaf != nil {
+				iterator.index = len(iterator.leaf.Entries) - 1
+			}
+		}
+	case end:
+		iterator.leaf = iterator.tree.Right()
+		if iterator.leaf != nil {
+			iterator.index = len(iterator.leaf.Entries) - 1
+		}
+	}
+	if iterator.leaf == nil {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Seek moves the iterator to the first element whose key is larger than or equal to the given key
+// and returns true if there was such an element in the container.
+// Otherwise the iterator is moved past the last element (one-past-the-end).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) Seek(key any) bool {
+	leaf, index := iterator.tree.ceilingEntry(key)
+	if leaf == nil {
+		iterator.End()
+		return false
+	}
+	iterator.leaf, iterator.index, iterator.position = leaf, index, between
+	return true
+}
+
+// SeekReverse moves the iterator to the last element whose key is smaller than or equal to the given key
+// and returns true if there was such an element in the container.
+// Otherwise the iterator is moved to its initial state (one-before-first).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekReverse(key any) bool {
+	if iterator.tree.Empty() {
+		iterator.Begin()
+		return false
+	}
+	leaf := iterator.tree.findLeaf(key)
+	index, found := iterator.tree.search(leaf, key)
+	if !found {
+		index--
+	}
+	if index < 0 {
+		leaf = leaf.Prev
+		if leaf != nil {
+			index = len(leaf.Entries) - 1
+		}
+	}
+	if leaf == nil {
+		iterator.Begin()
+		return false
+	}
+	iterator.leaf, iterator.index, iterator.position = leaf, index, between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() any {
+	return iterator.leaf.Entries[iterator.index].Value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Key() any {
+	return iterator.leaf.Entries[iterator.index].Key
+}
+
+// CurrentNode returns the current leaf of the iterator
+func (iterator *Iterator) CurrentNode() *Node {
+	return iterator.leaf
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.leaf = nil
+	iterator.index = 0
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.leaf = nil
+	iterator.index = 0
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// RangeIterator holding the range iterator's state
+type RangeIterator struct {
+	tree     *Tree
+	leaf     *Node
+	index    int
+	from     any
+	to       any
+	position position
+}
+
+// RangeIterator returns a stateful iterator over the elements whose keys are larger than or equal to from
+// and smaller than to. A nil bound leaves the corresponding side of the range open.
+// The iterator descends to the leaf holding a bound once and then walks the chain of linked leaves,
+// so moving to the next or previous element takes constant time.
+//
+// Bounds should adhere to the comparator's type assertion, otherwise iteration panics.
+func (tree *Tree) RangeIterator(from any, to any) RangeIterator {
+	return RangeIterator{tree: tree, from: from, to: to, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the range.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *RangeIterator) Next() bool {
+	switch iterator.position {
+	case begin:
+		if iterator.from == nil {
+			iterator.leaf, iterator.index = iterator.tree.Left(), 0
+		} else {
+			iterator.leaf, iterator.index = iterator.tree.ceilingEntry(iterator.from)
+		}
+	case between:
+		iterator.index++
+		if iterator.index >= len(iterator.leaf.Entries) {
+			iterator.leaf, iterator.index = iterator.leaf.Next, 0
+		}
+	case end:
+		iterator.leaf = nil
+	}
+	if iterator.leaf == nil || !iterator.belowTo() {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the range.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *RangeIterator) Prev() bool {
+	switch iterator.position {
+	case begin:
+		iterator.leaf = nil
+	case between:
+		iterator.leaf, iterator.index = previousEntry(iterator.leaf, iterator.index)
+	case end:
+		// step back from the first entry not below the upper bound, or from past the right-most leaf
+		var leaf *Node
+		if iterator.to != nil {
+			leaf, iterator.index = iterator.tree.ceilingEntry(iterator.to)
+		}
+		if leaf == nil {
+			leaf = iterator.tree.Right()
+			if leaf != nil {
+				iterator.index = len(leaf.Entries)
+			}
+		}
+		iterator.leaf = nil
+		if leaf != nil {
+			iterator.leaf, iterator.index = previousEntry(leaf, iterator.index)
+		}
+	}
+	if iterator.leaf == nil || !iterator.aboveFrom() {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Seek moves the iterator to the first element in the range whose key is larger than or equal to the given key
+// and returns true if there was such an element.
+// Otherwise the iterator is moved past the last element (one-past-the-end).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *RangeIterator) Seek(key any) bool {
+	if iterator.from != nil && iterator.tree.Comparator(key, iterator.from) < 0 {
+		key = iterator.from
+	}
+	iterator.leaf, iterator.index = iterator.tree.ceilingEntry(key)
+	if iterator.leaf == nil || !iterator.belowTo() {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *RangeIterator) Value() any {
+	return iterator.leaf.Entries[iterator.index].Value
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *RangeIterator) Key() any {
+	return iterator.leaf.Entries[iterator.index].Key
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *RangeIterator) Begin() {
+	iterator.leaf = nil
+	iterator.index = 0
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *RangeIterator) End() {
+	iterator.leaf = nil
+	iterator.index = 0
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the range.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *RangeIterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the range.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *RangeIterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+func (iterator *RangeIterator) aboveFrom() bool {
+	return iterator.from == nil || iterator.tree.Comparator(iterator.Key(), iterator.from) >= 0
+}
+
+func (iterator *RangeIterator) belowTo() bool {
+	return iterator.to == nil || iterator.tree.Comparator(iterator.Key(), iterator.to) < 0
+}
+
+// ceilingEntry returns the leaf and index of the first entry whose key is larger than or equal to the given key,
+// otherwise (nil,0)
+func (tree *Tree) ceilingEntry(key any) (*Node, int) {
+	if tree.Empty() {
+		return nil, 0
+	}
+	leaf := tree.findLeaf(key)
+	index, _ := tree.search(leaf, key)
+	if index >= len(leaf.Entries) {
+		return leaf.Next, 0
+	}
+	return leaf, index
+}
+
+// previousEntry returns the leaf and index of the entry before the one at the given index of the leaf, otherwise (nil,0)
+func previousEntry(leaf *Node, index int) (*Node, int) {
+	if index > 0 {
+		return leaf, index - 1
+	}
+	if leaf = leaf.Prev; leaf == nil {
+		return nil, 0
+	}
+	return leaf, len(leaf.Entries) - 1
+}
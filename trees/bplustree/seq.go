@@ -0,0 +1,40 @@
+package bplustree
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the keys and values of the tree in-order, for use with range.
+func (tree *Tree) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		for it := tree.Iterator(); it.Next(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the tree in-order, for use with range.
+func (tree *Tree) SeqKeys() iter.Seq[any] {
+	return seq.Keys(tree.Seq())
+}
+
+// SeqValues returns a sequence of the values of the tree in-order based on the key, for use with range.
+func (tree *Tree) SeqValues() iter.Seq[any] {
+	return seq.Values(tree.Seq())
+}
+
+// Backward returns a sequence of the keys and values of the tree in reverse order, for use with range.
+func (tree *Tree) Backward() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		it := tree.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+	}
+}
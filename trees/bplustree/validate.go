@@ -0,0 +1,101 @@
+package bplustree
+
+import "fmt"
+
+// Validate checks the structural invariants of the tree and returns an error describing the first violation found,
+// or nil if the tree is a valid B+ tree.
+//
+// Checked are the number of routing keys, children and entries of every node against the tree's order,
+// that all leaves are on the same level, the parent pointers, that every key lies within the range of its routing keys,
+// the order of the keys, the links between the leaves and the tree's size.
+// Validation visits every node, so it is meant for tests and debugging.
+func (tree *Tree) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("bplustree: empty tree has size %v", tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("bplustree: root has a parent")
+	}
+	var previous *Node
+	size, leafDepth := 0, -1
+	var validate func(node *Node, depth int, low any, high any) error
+	validate = func(node *Node, depth int, low any, high any) error {
+		if !node.isLeaf() {
+			if len(node.Keys) != len(node.Children)-1 {
+				return fmt.Errorf("bplustree: node %v has %v routing keys but %v children", node.Keys, len(node.Keys), len(node.Children))
+			}
+			if len(node.Children) > tree.maxChildren() {
+				return fmt.Errorf("bplustree: node %v has %v children, at most %v allowed", node.Keys, len(node.Children), tree.maxChildren())
+			}
+			if node != tree.Root && len(node.Children) < tree.minChildren() {
+				return fmt.Errorf("bplustree: node %v has %v children, at least %v required", node.Keys, len(node.Children), tree.minChildren())
+			}
+			for i, child := range node.Children {
+				if child.Parent != node {
+					return fmt.Errorf("bplustree: child %v of node %v has a wrong parent", i, node.Keys)
+				}
+				childLow, childHigh := low, high
+				if i > 0 {
+					childLow = node.Keys[i-1]
+				}
+				if i < len(node.Keys) {
+					childHigh = node.Keys[i]
+				}
+				if err := validate(child, depth+1, childLow, childHigh); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if leafDepth == -1 {
+			leafDepth = depth
+		} else if leafDepth != depth {
+			return fmt.Errorf("bplustree: leaf %v is at depth %v, other leaves at depth %v", leafKeys(node), depth, leafDepth)
+		}
+		if len(node.Entries) > tree.maxEntries() {
+			return fmt.Errorf("bplustree: leaf %v has %v entries, at most %v allowed", leafKeys(node), len(node.Entries), tree.maxEntries())
+		}
+		if node != tree.Root && len(node.Entries) < tree.minEntries() {
+			return fmt.Errorf("bplustree: leaf %v has %v entries, at least %v required", leafKeys(node), len(node.Entries), tree.minEntries())
+		}
+		if node.Prev != previous || (previous != nil && previous.Next != node) {
+			return fmt.Errorf("bplustree: leaf %v is not linked to its left neighbour", leafKeys(node))
+		}
+		for _, entry := range node.Entries {
+			if (low != nil && tree.Comparator(entry.Key, low) < 0) || (high != nil && tree.Comparator(entry.Key, high) >= 0) {
+				return fmt.Errorf("bplustree: key %v is outside of its routing range [%v, %v)", entry.Key, low, high)
+			}
+		}
+		for i := 1; i < len(node.Entries); i++ {
+			if tree.Comparator(node.Entries[i-1].Key, node.Entries[i].Key) >= 0 {
+				return fmt.Errorf("bplustree: key %v is not smaller than its successor %v", node.Entries[i-1].Key, node.Entries[i].Key)
+			}
+		}
+		previous = node
+		size += len(node.Entries)
+		return nil
+	}
+	if err := validate(tree.Root, 0, nil, nil); err != nil {
+		return err
+	}
+	if previous.Next != nil {
+		return fmt.Errorf("bplustree: last leaf %v is linked to a right neighbour", leafKeys(previous))
+	}
+	if size != tree.size {
+		return fmt.Errorf("bplustree: tree has size %v but holds %v entries", tree.size, size)
+	}
+	return nil
+}
+
+// leafKeys returns the keys of the leaf's entries for error messages.
+func leafKeys(node *Node) []any {
+	keys := make([]any, len(node.Entries))
+	for i, entry := range node.Entries {
+		keys[i] = entry.Key
+	}
+	return keys
+}
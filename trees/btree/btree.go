@@ -14,22 +14,25 @@ package btree
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/Arafatk/Dataviz/trees"
 	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 var _ trees.Tree = (*Tree)(nil)
 
 // Tree holds elements of the B-tree
 type Tree struct {
-	Root       *Node            // Root node
-	Comparator utils.Comparator // Key comparator
-	size       int              // Total number of keys in the tree
-	m          int              // order (maximum number of children)
+	Root       *Node             // Root node
+	Comparator utils.Comparator  // Key comparator
+	KeyCodec   keycodec.KeyCodec // Key codec of the JSON representation, nil for a JSON object with string keys
+	size       int               // Total number of keys in the tree
+	m          int               // order (maximum number of children)
 
 }
 
@@ -216,6 +219,80 @@ func (tree *Tree) Clear() {
 	tree.size = 0
 }
 
+// FromSorted replaces the contents of the tree with the given key-value pairs, packing the nodes as full as possible.
+// Keys must be sorted in strictly ascending order with respect to the tree's comparator and
+// there must be as many values as keys, otherwise an error is returned and the tree is left unchanged.
+func (tree *Tree) FromSorted(keys []any, values []any) error {
+	return tree.FromSortedWithFillFactor(keys, values, 1)
+}
+
+// FromSortedWithFillFactor replaces the contents of the tree with the given key-value pairs, building the tree bottom-up.
+// The fill factor in range (0,1] determines how full the nodes are packed, leaving room for later insertions
+// without splitting. Nodes never hold less than the minimum number of entries required by the tree's order.
+// Keys must be sorted in strictly ascending order with respect to the tree's comparator and
+// there must be as many values as keys, otherwise an error is returned and the tree is left unchanged.
+//
+// Building takes O(n) time instead of the O(n log n) required by repeated calls to Put.
+func (tree *Tree) FromSortedWithFillFactor(keys []any, values []any, fillFactor float64) error {
+	if fillFactor <= 0 || fillFactor > 1 {
+		return errors.New("btree: fill factor should be in range (0,1]")
+	}
+	if len(keys) != len(values) {
+		return errors.New("btree: number of keys and values differ")
+	}
+	for i := 1; i < len(keys); i++ {
+		if tree.Comparator(keys[i-1], keys[i]) >= 0 {
+			return errors.New("btree: keys are not sorted in strictly ascending order")
+		}
+	}
+
+	tree.Clear()
+	if len(keys) == 0 {
+		return nil
+	}
+	capacity := int(fillFactor * float64(tree.maxEntries()))
+	if capacity < tree.minEntries() {
+		capacity = tree.minEntries()
+	}
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	entries := make([]*Entry, len(keys))
+	for i := range keys {
+		entries[i] = &Entry{Key: keys[i], Value: values[i]}
+	}
+
+	// Every level is a sequence of nodes separated by single entries, which are promoted to the level above.
+	var children []*Node
+	for {
+		sizes := tree.pack(len(entries), capacity)
+		level := make([]*Node, len(sizes))
+		separators := []*Entry{}
+		for i, size := range sizes {
+			node := &Node{Entries: append([]*Entry(nil), entries[:size]...)}
+			entries = entries[size:]
+			if children != nil {
+				node.Children = append([]*Node(nil), children[:size+1]...)
+				children = children[size+1:]
+				setParent(node.Children, node)
+			}
+			if i < len(sizes)-1 {
+				separators = append(separators, entries[0])
+				entries = entries[1:]
+			}
+			level[i] = node
+		}
+		if len(level) == 1 {
+			tree.Root = level[0]
+			break
+		}
+		entries, children = separators, level
+	}
+	tree.size = len(keys)
+	return nil
+}
+
 // Height returns the height of the tree.
 func (tree *Tree) Height() int {
 	return tree.Root.height()
@@ -335,6 +412,24 @@ func (tree *Tree) middle() int {
 	return (tree.m - 1) / 2 // "-1" to favor right nodes to have more keys when splitting
 }
 
+// pack splits count entries into the sizes of nodes holding about capacity entries each,
+// with a single separating entry between neighbouring nodes.
+func (tree *Tree) pack(count int, capacity int) []int {
+	nodes := (count + 1 + capacity) / (capacity + 1) // ceil((count+1)/(capacity+1))
+	for nodes > 1 && (count-nodes+1)/nodes < tree.minEntries() {
+		nodes--
+	}
+	sizes := make([]int, nodes)
+	entries := count - nodes + 1
+	for i := range sizes {
+		sizes[i] = entries / nodes
+		if i < entries%nodes {
+			sizes[i]++
+		}
+	}
+	return sizes
+}
+
 // search searches only within the single node among its entries
 func (tree *Tree) search(node *Node, key any) (index int, found bool) {
 	low, high := 0, len(node.Entries)-1
@@ -372,6 +467,46 @@ func (tree *Tree) searchRecursively(startNode *Node, key any) (node *Node, index
 	}
 }
 
+// ceiling returns the node and entry with the smallest key larger than or equal to the given key, otherwise (nil,nil)
+func (tree *Tree) ceiling(key any) (*Node, *Entry) {
+	var ceilingNode *Node
+	var ceilingEntry *Entry
+	for node := tree.Root; node != nil; {
+		index, found := tree.search(node, key)
+		if found {
+			return node, node.Entries[index]
+		}
+		if index < len(node.Entries) {
+			ceilingNode, ceilingEntry = node, node.Entries[index]
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return ceilingNode, ceilingEntry
+}
+
+// floor returns the node and entry with the largest key smaller than or equal to the given key, otherwise (nil,nil)
+func (tree *Tree) floor(key any) (*Node, *Entry) {
+	var floorNode *Node
+	var floorEntry *Entry
+	for node := tree.Root; node != nil; {
+		index, found := tree.search(node, key)
+		if found {
+			return node, node.Entries[index]
+		}
+		if index > 0 {
+			floorNode, floorEntry = node, node.Entries[index-1]
+		}
+		if tree.isLeaf(node) {
+			break
+		}
+		node = node.Children[index]
+	}
+	return floorNode, floorEntry
+}
+
 func (tree *Tree) insert(node *Node, entry *Entry) (inserted bool) {
 	if tree.isLeaf(node) {
 		return tree.insertIntoLeaf(node, entry)
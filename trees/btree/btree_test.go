@@ -1,8 +1,18 @@
 package btree
 
 import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
 	"fmt"
+	"io"
+	"math/rand"
+	"slices"
+	"strings"
 	"testing"
+
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 func TestBTreeGet1(t *testing.T) {
@@ -1005,6 +1015,129 @@ func TestBTreeIteratorLast(t *testing.T) {
 	}
 }
 
+func TestBTreeIteratorSeek(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 2; i <= 40; i += 2 {
+		tree.Put(i, i*10)
+	}
+	it := tree.Iterator()
+
+	// key, expectedFound, expectedKey
+	tests := [][]any{
+		{0, true, 2},
+		{2, true, 2},
+		{13, true, 14},
+		{40, true, 40},
+		{41, false, nil},
+	}
+	for _, test := range tests {
+		found := it.Seek(test[0])
+		if found != test[1] {
+			t.Errorf("Seek(%v): got %v expected %v", test[0], found, test[1])
+		}
+		if found && it.Key() != test[2] {
+			t.Errorf("Seek(%v): got %v expected %v", test[0], it.Key(), test[2])
+		}
+	}
+
+	// seeking into the middle and continuing in both directions
+	it.Seek(21)
+	keys := []any{it.Key()}
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v", keys), "[22 24 26 28 30 32 34 36 38 40]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Seek(7)
+	if actualValue, expectedValue := it.Prev(), true; actualValue != expectedValue || it.Key() != 6 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 6)
+	}
+
+	// key, expectedFound, expectedKey
+	tests = [][]any{
+		{1, false, nil},
+		{2, true, 2},
+		{13, true, 12},
+		{100, true, 40},
+	}
+	for _, test := range tests {
+		found := it.SeekReverse(test[0])
+		if found != test[1] {
+			t.Errorf("SeekReverse(%v): got %v expected %v", test[0], found, test[1])
+		}
+		if found && it.Key() != test[2] {
+			t.Errorf("SeekReverse(%v): got %v expected %v", test[0], it.Key(), test[2])
+		}
+	}
+	it.SeekReverse(1)
+	if actualValue, expectedValue := it.Next(), true; actualValue != expectedValue || it.Key() != 2 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 2)
+	}
+
+	empty := NewWithIntComparator(3).Iterator()
+	if actualValue, expectedValue := empty.Seek(1), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := empty.SeekReverse(1), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeRangeIterator(t *testing.T) {
+	tree := NewWithIntComparator(4)
+	for i := 1; i <= 30; i++ {
+		tree.Put(i, i)
+	}
+
+	tests := []struct {
+		from, to any
+		expected string
+	}{
+		{10, 15, "[10 11 12 13 14]"},
+		{nil, 4, "[1 2 3]"},
+		{27, nil, "[27 28 29 30]"},
+		{-5, 3, "[1 2]"},
+		{29, 100, "[29 30]"},
+		{15, 15, "[]"},
+		{40, 50, "[]"},
+	}
+	for _, test := range tests {
+		it := tree.RangeIterator(test.from, test.to)
+		keys := []any{}
+		for it.Next() {
+			keys = append(keys, it.Key())
+		}
+		if actualValue := fmt.Sprintf("%v", keys); actualValue != test.expected {
+			t.Errorf("[%v,%v) next: got %v expected %v", test.from, test.to, actualValue, test.expected)
+		}
+		reversed := []any{}
+		for it.Prev() {
+			reversed = append([]any{it.Key()}, reversed...)
+		}
+		if actualValue := fmt.Sprintf("%v", reversed); actualValue != test.expected {
+			t.Errorf("[%v,%v) prev: got %v expected %v", test.from, test.to, actualValue, test.expected)
+		}
+	}
+
+	it := tree.RangeIterator(10, 20)
+	if actualValue, expectedValue := it.Seek(5), true; actualValue != expectedValue || it.Key() != 10 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 10)
+	}
+	if actualValue, expectedValue := it.Seek(17), true; actualValue != expectedValue || it.Value() != 17 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Value(), expectedValue, 17)
+	}
+	if actualValue, expectedValue := it.Seek(20), false; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue || it.Key() != 19 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 19)
+	}
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue || it.Key() != 10 {
+		t.Errorf("Got %v,%v expected %v,%v", actualValue, it.Key(), expectedValue, 10)
+	}
+}
+
 func TestBTree_search(t *testing.T) {
 	{
 		tree := NewWithIntComparator(3)
@@ -1101,6 +1234,500 @@ func TestBTreeSerialization(t *testing.T) {
 	assert()
 }
 
+func TestBTreeFromSorted(t *testing.T) {
+	for _, order := range []int{3, 4, 5, 10} {
+		for _, fillFactor := range []float64{0.1, 0.5, 1} {
+			for size := 0; size <= 120; size += 7 {
+				keys := make([]any, size)
+				values := make([]any, size)
+				for i := range keys {
+					keys[i], values[i] = i, fmt.Sprintf("v%d", i)
+				}
+				tree := NewWithIntComparator(order)
+				tree.Put(-1, "replaced")
+				if err := tree.FromSortedWithFillFactor(keys, values, fillFactor); err != nil {
+					t.Errorf("Got error %v", err)
+				}
+				assertValidBTree(t, tree, size)
+				if actualValue, expectedValue := fmt.Sprintf("%v", tree.Keys()), fmt.Sprintf("%v", keys); actualValue != expectedValue {
+					t.Errorf("Got %v expected %v", actualValue, expectedValue)
+				}
+				tree.Put(size, "x")
+				tree.Remove(0)
+				assertValidBTree(t, tree, size)
+			}
+		}
+	}
+
+	// a lower fill factor leaves room in the leaves
+	full, sparse := NewWithIntComparator(5), NewWithIntComparator(5)
+	keys := make([]any, 100)
+	for i := range keys {
+		keys[i] = i
+	}
+	full.FromSorted(keys, keys)
+	sparse.FromSortedWithFillFactor(keys, keys, 0.5)
+	if actualValue, expectedValue := len(full.Left().Entries), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := len(sparse.Left().Entries); actualValue > 3 {
+		t.Errorf("Got %v expected at most %v", actualValue, 3)
+	}
+	if err := full.FromSortedWithFillFactor(keys, keys, 0); err == nil {
+		t.Errorf("Expected error for invalid fill factor")
+	}
+
+	tree := NewWithIntComparator(3)
+	if err := tree.FromSorted([]any{1, 3, 2}, []any{1, 3, 2}); err == nil {
+		t.Errorf("Expected error for unsorted keys")
+	}
+	if err := tree.FromSorted([]any{1, 1}, []any{1, 1}); err == nil {
+		t.Errorf("Expected error for duplicate keys")
+	}
+	if err := tree.FromSorted([]any{1, 2}, []any{1}); err == nil {
+		t.Errorf("Expected error for mismatched values")
+	}
+}
+
+func TestBTreeFromJSONSorted(t *testing.T) {
+	tree := NewWithStringComparator(3)
+	for i := 0; i < 100; i++ {
+		tree.Put(fmt.Sprintf("k%03d", i), i)
+	}
+	json, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithStringComparator(3)
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	assertValidBTree(t, loaded, 100)
+	if actualValue, expectedValue := fmt.Sprintf("%v", loaded.Keys()), fmt.Sprintf("%v", tree.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.FromJSON([]byte(`{"b":1,"a":2,"b":3}`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[a b][2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.FromJSON([]byte(`[1,2]`)); err == nil {
+		t.Errorf("Expected error for non-object input")
+	}
+}
+
+func TestBTreeKeyCodec(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	tree.Put(3, "c")
+	tree.Put(1, "a")
+	tree.Put(2, "b")
+
+	tree.KeyCodec = keycodec.JSON[int]{}
+	json, err := tree.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(json), `[{"key":1,"value":"a"},{"key":2,"value":"b"},{"key":3,"value":"c"}]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded := NewWithIntComparator(3)
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[1 2 3][a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	// typed keys are restored without a codec
+	tree.KeyCodec = keycodec.Typed
+	if json, err = tree.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded = NewWithIntComparator(3)
+	if err := loaded.FromJSON(json); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, found := loaded.Get(2); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// unordered pairs, later keys replacing earlier ones
+	if err := loaded.FromJSON([]byte(`[{"key":{"type":"int","value":2},"value":"x"},{"key":{"type":"int","value":1},"value":"y"},{"key":{"type":"int","value":2},"value":"z"}]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[1 2][y z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	if err := loaded.FromJSON([]byte(`[{"value":1}]`)); err == nil {
+		t.Errorf("Expected error for a pair without a key")
+	}
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.FromJSON([]byte(`[{"key":"a","value":1}]`)); err == nil {
+		t.Errorf("Expected error for a key of the wrong type")
+	}
+
+	// without a codec the keys are restored as strings, which the IntComparator cannot compare
+	tree.KeyCodec = nil
+	if json, err = tree.ToJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded = NewWithIntComparator(3)
+	loaded.Put(5, "e")
+	if err := loaded.FromJSON(json); err == nil {
+		t.Errorf("Expected error for string keys of a tree with the IntComparator")
+	}
+	if actualValue, expectedValue := fmt.Sprintf("%v%v", loaded.Keys(), loaded.Values()), "[5][e]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded = NewWithIntComparator(3)
+	if err := loaded.FromJSON([]byte(`{"1":"a"}`)); err == nil || !loaded.Empty() {
+		t.Errorf("Expected error for a string key of a tree with the IntComparator")
+	}
+}
+
+func TestBTreeBinary(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for _, i := range rand.Perm(100) {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	data, err := tree.MarshalBinary()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator(3)
+	loaded.Put(-1, "replaced")
+	if err := loaded.UnmarshalBinary(data); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Keys(), loaded.Values()), fmt.Sprint(tree.Keys(), tree.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("Expected error for truncated data")
+	}
+	if err := (&Tree{}).UnmarshalBinary(data); err == nil {
+		t.Errorf("Expected error for a tree without a comparator")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(tree); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := NewWithIntComparator(3)
+	if err := gob.NewDecoder(&buffer).Decode(decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Keys()), fmt.Sprint(tree.Keys()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestBTreeWriteReadJSON(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 12; i >= 8; i-- {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	var buffer bytes.Buffer
+	if err := tree.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := buffer.String(), `{"8":"8","9":"9","10":"10","11":"11","12":"12"}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	json, err := tree.ToJSON()
+	if actualValue, expectedValue := string(json), buffer.String(); actualValue != expectedValue || err != nil {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	tree.KeyCodec = keycodec.JSON[int]{}
+	buffer.Reset()
+	if err := tree.WriteJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithIntComparator(3)
+	loaded.KeyCodec = keycodec.JSON[int]{}
+	if err := loaded.ReadJSON(&buffer); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded.Keys(), loaded.Values()), fmt.Sprint(tree.Keys(), tree.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := loaded.ReadJSON(strings.NewReader(`{"a":1`)); err == nil {
+		t.Errorf("Expected error for truncated input")
+	}
+}
+
+func TestBTreeMarshalJSON(t *testing.T) {
+	type document struct {
+		Name string
+		Tree *Tree
+	}
+	tree := NewWithStringComparator(3)
+	tree.Put("c", 3.0)
+	tree.Put("a", 1.0)
+	tree.Put("b", 2.0)
+	data, err := json.Marshal(document{Name: "letters", Tree: tree})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `{"Name":"letters","Tree":{"a":1,"b":2,"c":3}}`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	decoded := document{Tree: NewWithStringComparator(3)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := decoded.Name, "letters"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Tree.Keys(), decoded.Tree.Values()), "[a b c] [1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a tree without a comparator")
+	}
+}
+
+func TestBTreeShapeJSON(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for _, i := range rand.Perm(200) {
+		tree.Put(i, fmt.Sprint(i))
+	}
+	for i := 0; i < 200; i += 3 {
+		tree.Remove(i)
+	}
+	shape, err := tree.ToShapeJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+
+	loaded := NewWithIntComparator(3)
+	loaded.Put(-1, "replaced")
+	if err := loaded.FromShapeJSON(shape); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := NewWithStringComparator(3).FromShapeJSON(shape); err == nil {
+		t.Errorf("Expected error for int keys compared by the StringComparator")
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, expectedValue := loaded.String(), tree.String(); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	reshaped, err := loaded.ToShapeJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(reshaped), string(shape); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	loaded.Put(1000, "x")
+	loaded.Remove(1)
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	empty := NewWithIntComparator(3)
+	if shape, err = empty.ToShapeJSON(); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.FromShapeJSON(shape); err != nil || loaded.Size() != 0 {
+		t.Errorf("Got %v,%v expected an empty tree", loaded.Size(), err)
+	}
+
+	loaded.Put(1, "a")
+	for _, input := range []string{`[]`, `{"order"`, `2,"root":null}:{"order":4,"root":{"entries":[{"key":{"type":"int","value":1},"value":"a"}],"children":[{"entries":[]},{"entries":[{"key":{"type":"int","value":2},"value":"b"}]}]}}`} {
+		if err := loaded.FromShapeJSON([]byte(input)); err == nil {
+			t.Errorf("Expected error for %v", input)
+		}
+	}
+	if actualValue, found := loaded.Get(1); actualValue != "a" || !found || loaded.Size() != 1 {
+		t.Errorf("Got %v expected the tree to be unchanged", loaded)
+	}
+}
+
+// assertValidBTree checks the size, node occupancy, leaf depth, parent pointers and key order of the tree.
+func TestBTreeValidate(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+	for i := 1; i <= 20; i++ {
+		tree.Put(i, i)
+		if err := tree.Validate(); err != nil {
+			t.Errorf("Got %v expected nil", err)
+		}
+	}
+
+	leaf := tree.Right()
+	leaf.Entries = append(leaf.Entries, &Entry{21, 21}, &Entry{22, 22})
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "at most") {
+		t.Errorf("Got %v expected an overflow violation", err)
+	}
+	leaf.Entries = leaf.Entries[:len(leaf.Entries)-2]
+
+	entries := leaf.Entries
+	leaf.Entries = nil
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "at least") {
+		t.Errorf("Got %v expected an underflow violation", err)
+	}
+	leaf.Entries = entries
+
+	key := leaf.Entries[0].Key
+	leaf.Entries[0].Key = 0
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "successor") {
+		t.Errorf("Got %v expected an order violation", err)
+	}
+	leaf.Entries[0].Key = key
+
+	parent := leaf.Parent
+	leaf.Parent = nil
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "parent") {
+		t.Errorf("Got %v expected a parent violation", err)
+	}
+	leaf.Parent = parent
+
+	for range append(leaf.Entries, nil) {
+		leaf.Children = append(leaf.Children, &Node{Parent: leaf, Entries: []*Entry{{21, 21}}})
+	}
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "depth") {
+		t.Errorf("Got %v expected a leaf depth violation", err)
+	}
+	leaf.Children = nil
+
+	tree.size--
+	if err := tree.Validate(); err == nil || !strings.Contains(err.Error(), "size") {
+		t.Errorf("Got %v expected a size violation", err)
+	}
+	tree.size++
+	if err := tree.Validate(); err != nil {
+		t.Errorf("Got %v expected nil", err)
+	}
+}
+
+func assertValidBTree(t *testing.T, tree *Tree, expectedSize int) {
+	t.Helper()
+	assertValidTree(t, tree, expectedSize)
+	if err := tree.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBTreeSeq(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 5; i > 0; i-- {
+		tree.Put(i, fmt.Sprint(i*10))
+	}
+	actual := []string{}
+	for key, value := range tree.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[1:10 2:20 3:30 4:40 5:50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(tree.SeqKeys())), "[1 2 3 4 5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(tree.SeqValues())), "[10 20 30 40 50]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	actual = actual[:0]
+	for key, value := range tree.Backward() {
+		if key == 2 {
+			break
+		}
+		actual = append(actual, fmt.Sprintf("%v:%v", key, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[5:50 4:40 3:30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func FuzzBTree(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckMap(NewWithIntComparator(3), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestBTreeEnumerable(t *testing.T) {
+	tree := NewWithIntComparator(3)
+	for i := 1; i <= 6; i++ {
+		tree.Put(i, fmt.Sprint(i))
+	}
+
+	var keys []any
+	tree.Each(func(key any, value any) { keys = append(keys, key) })
+	if actualValue, expectedValue := fmt.Sprint(keys), "[1 2 3 4 5 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	mapped := tree.Map(func(key any, value any) (any, any) { return -key.(int), value.(string) + value.(string) })
+	if actualValue, expectedValue := fmt.Sprint(mapped.Keys(), mapped.Values()), "[-6 -5 -4 -3 -2 -1] [66 55 44 33 22 11]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	even := tree.Select(func(key any, value any) bool { return key.(int)%2 == 0 })
+	if actualValue, expectedValue := fmt.Sprint(even.Keys()), "[2 4 6]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	even.Put(7, "7")
+	if actualValue, expectedValue := fmt.Sprint(even.Keys()), "[2 4 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if tree.Size() != 6 {
+		t.Errorf("Got %v expected %v", tree.Size(), 6)
+	}
+
+	if actualValue := tree.Any(func(key any, value any) bool { return value == "3" }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree.Any(func(key any, value any) bool { return key.(int) > 6 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := tree.All(func(key any, value any) bool { return key.(int) > 0 }); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := tree.All(func(key any, value any) bool { return key.(int) < 6 }); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	key, value := tree.Find(func(key any, value any) bool { return key.(int) > 3 })
+	if key != 4 || value != "4" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 4, "4")
+	}
+	key, value = tree.Find(func(key any, value any) bool { return key.(int) > 6 })
+	if key != nil || value != nil {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, nil, nil)
+	}
+}
+
+func BenchmarkBTreeFromSorted100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	keys := make([]any, size)
+	values := make([]any, size)
+	for n := 0; n < size; n++ {
+		keys[n], values[n] = n, struct{}{}
+	}
+	tree := NewWithIntComparator(128)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		tree.FromSorted(keys, values)
+	}
+}
+
 func benchmarkGet(b *testing.B, tree *Tree, size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
@@ -1253,3 +1880,18 @@ func BenchmarkBTreeRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, tree, size)
 }
+
+func BenchmarkBTreeWriteJSON100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	tree := NewWithIntComparator(128)
+	for n := 0; n < size; n++ {
+		tree.Put(n, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		if err := tree.WriteJSON(io.Discard); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
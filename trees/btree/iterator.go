@@ -3,6 +3,7 @@ package btree
 import "github.com/Arafatk/Dataviz/containers"
 
 var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
+var _ containers.ReverseIteratorWithKey = (*RangeIterator)(nil)
 
 // Iterator holding the iterator's state
 type Iterator struct {
@@ -190,3 +191,189 @@ func (iterator *Iterator) Last() bool {
 	iterator.End()
 	return iterator.Prev()
 }
+
+// Seek moves the iterator to the first element whose key is larger than or equal to the given key
+// and returns true if there was such an element in the container.
+// If Seek() returns true, then the element's key and value can be retrieved by Key() and Value().
+// Otherwise the iterator is moved past the last element (one-past-the-end).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) Seek(key any) bool {
+	node, entry := iterator.tree.ceiling(key)
+	if entry == nil {
+		iterator.End()
+		return false
+	}
+	iterator.node = node
+	iterator.entry = entry
+	iterator.position = between
+	return true
+}
+
+// SeekReverse moves the iterator to the last element whose key is smaller than or equal to the given key
+// and returns true if there was such an element in the container.
+// If SeekReverse() returns true, then the element's key and value can be retrieved by Key() and Value().
+// Otherwise the iterator is moved to its initial state (one-before-first).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *Iterator) SeekReverse(key any) bool {
+	node, entry := iterator.tree.floor(key)
+	if entry == nil {
+		iterator.Begin()
+		return false
+	}
+	iterator.node = node
+	iterator.entry = entry
+	iterator.position = between
+	return true
+}
+
+// RangeIterator holding the range iterator's state
+type RangeIterator struct {
+	iterator Iterator
+	from     any
+	to       any
+	position position
+}
+
+// RangeIterator returns a stateful iterator over the elements whose keys are larger than or equal to from
+// and smaller than to. A nil bound leaves the corresponding side of the range open.
+//
+// Bounds should adhere to the comparator's type assertion, otherwise iteration panics.
+func (tree *Tree) RangeIterator(from any, to any) RangeIterator {
+	return RangeIterator{iterator: tree.Iterator(), from: from, to: to, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the range.
+// If Next() returns true, then next element's key and value can be retrieved by Key() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *RangeIterator) Next() bool {
+	var ok bool
+	switch iterator.position {
+	case begin:
+		ok = iterator.seekFrom()
+	case between:
+		ok = iterator.iterator.Next()
+	}
+	if !ok || !iterator.belowTo() {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the range.
+// If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *RangeIterator) Prev() bool {
+	var ok bool
+	switch iterator.position {
+	case end:
+		ok = iterator.seekTo()
+	case between:
+		ok = iterator.iterator.Prev()
+	}
+	if !ok || !iterator.aboveFrom() {
+		iterator.Begin()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Seek moves the iterator to the first element in the range whose key is larger than or equal to the given key
+// and returns true if there was such an element.
+// Otherwise the iterator is moved past the last element (one-past-the-end).
+// Modifies the state of the iterator.
+//
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (iterator *RangeIterator) Seek(key any) bool {
+	var ok bool
+	if iterator.from != nil && iterator.iterator.tree.Comparator(key, iterator.from) < 0 {
+		ok = iterator.seekFrom()
+	} else {
+		ok = iterator.iterator.Seek(key)
+	}
+	if !ok || !iterator.belowTo() {
+		iterator.End()
+		return false
+	}
+	iterator.position = between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *RangeIterator) Value() any {
+	return iterator.iterator.Value()
+}
+
+// Key returns the current element's key.
+// Does not modify the state of the iterator.
+func (iterator *RangeIterator) Key() any {
+	return iterator.iterator.Key()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *RangeIterator) Begin() {
+	iterator.iterator.Begin()
+	iterator.position = begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *RangeIterator) End() {
+	iterator.iterator.End()
+	iterator.position = end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the range.
+// If First() returns true, then first element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator
+func (iterator *RangeIterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the range.
+// If Last() returns true, then last element's key and value can be retrieved by Key() and Value().
+// Modifies the state of the iterator.
+func (iterator *RangeIterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// seekFrom positions the underlying iterator at the first element of the range, ignoring the upper bound.
+func (iterator *RangeIterator) seekFrom() bool {
+	if iterator.from == nil {
+		return iterator.iterator.First()
+	}
+	return iterator.iterator.Seek(iterator.from)
+}
+
+// seekTo positions the underlying iterator at the last element of the range, ignoring the lower bound.
+func (iterator *RangeIterator) seekTo() bool {
+	if iterator.to == nil {
+		return iterator.iterator.Last()
+	}
+	if !iterator.iterator.SeekReverse(iterator.to) {
+		return false
+	}
+	if iterator.iterator.tree.Comparator(iterator.iterator.Key(), iterator.to) == 0 {
+		return iterator.iterator.Prev()
+	}
+	return true
+}
+
+func (iterator *RangeIterator) aboveFrom() bool {
+	return iterator.from == nil || iterator.iterator.tree.Comparator(iterator.iterator.Key(), iterator.from) >= 0
+}
+
+func (iterator *RangeIterator) belowTo() bool {
+	return iterator.to == nil || iterator.iterator.tree.Comparator(iterator.iterator.Key(), iterator.to) < 0
+}
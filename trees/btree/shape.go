@@ -0,0 +1,125 @@
+package btree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+// shapeEntry is the JSON representation of an entry within the tree's shape.
+type shapeEntry struct {
+	Key   json.RawMessage `json:"key"`
+	Value any             `json:"value"`
+}
+
+// shapeNode is the JSON representation of a node within the tree's shape.
+type shapeNode struct {
+	Entries  []shapeEntry `json:"entries"`
+	Children []*shapeNode `json:"children,omitempty"`
+}
+
+// shape is the JSON representation of the tree's shape.
+type shape struct {
+	Order int        `json:"order"`
+	Root  *shapeNode `json:"root"`
+}
+
+// ToShapeJSON outputs the JSON representation of the tree's exact shape, i.e. its order and every node with its entries and children:
+//
+//	{"order":3,"root":{"entries":[{"key":{"type":"int","value":2},"value":"b"}],"children":[{...},{...}]}}
+//
+// Keys are encoded by the tree's KeyCodec, or by keycodec.Typed if the tree has none.
+// Unlike ToJSON, whose output may be loaded into a tree of a different shape, FromShapeJSON restores the same tree node by node,
+// e.g. to save a scenario shown by the Visualizer and reproduce it later.
+func (tree *Tree) ToShapeJSON() ([]byte, error) {
+	codec := tree.keyCodec()
+	var encode func(node *Node) (*shapeNode, error)
+	encode = func(node *Node) (*shapeNode, error) {
+		shape := &shapeNode{Entries: make([]shapeEntry, len(node.Entries))}
+		for i, entry := range node.Entries {
+			key, err := codec.EncodeKey(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+			shape.Entries[i] = shapeEntry{Key: key, Value: entry.Value}
+		}
+		for _, child := range node.Children {
+			childShape, err := encode(child)
+			if err != nil {
+				return nil, err
+			}
+			shape.Children = append(shape.Children, childShape)
+		}
+		return shape, nil
+	}
+	output := shape{Order: tree.m}
+	if tree.Root != nil {
+		root, err := encode(tree.Root)
+		if err != nil {
+			return nil, err
+		}
+		output.Root = root
+	}
+	return json.Marshal(output)
+}
+
+// FromShapeJSON replaces the tree's elements and order with the ones of the input JSON representation output by ToShapeJSON,
+// keeping the entries of every node and its children as they are.
+// Returns an error and leaves the tree unchanged if the input does not represent a valid B-tree (see Validate).
+// Returns an error as well if the comparator cannot compare the decoded keys.
+func (tree *Tree) FromShapeJSON(data []byte) error {
+	var input shape
+	if err := json.Unmarshal(data, &input); err != nil {
+		return err
+	}
+	if input.Order < 3 {
+		return fmt.Errorf("btree: invalid order %v, should be at least 3", input.Order)
+	}
+	codec := tree.keyCodec()
+	var keys []any
+	var decode func(shape *shapeNode, parent *Node) (*Node, error)
+	decode = func(shape *shapeNode, parent *Node) (*Node, error) {
+		if shape == nil {
+			return nil, errors.New("btree: node is null")
+		}
+		node := &Node{Parent: parent, Entries: make([]*Entry, len(shape.Entries))}
+		for i, entry := range shape.Entries {
+			if entry.Key == nil {
+				return nil, errors.New("btree: entry has no key")
+			}
+			key, err := codec.DecodeKey(entry.Key)
+			if err != nil {
+				return nil, err
+			}
+			node.Entries[i] = &Entry{Key: key, Value: entry.Value}
+			keys = append(keys, key)
+		}
+		for _, childShape := range shape.Children {
+			child, err := decode(childShape, node)
+			if err != nil {
+				return nil, err
+			}
+			node.Children = append(node.Children, child)
+		}
+		return node, nil
+	}
+	loaded := &Tree{m: input.Order, Comparator: tree.Comparator}
+	if input.Root != nil {
+		root, err := decode(input.Root, nil)
+		if err != nil {
+			return err
+		}
+		loaded.Root, loaded.size = root, len(keys)
+	}
+	if err := keycodec.CheckKeys(keys, tree.Comparator); err != nil {
+		return err
+	}
+	if err := loaded.Validate(); err != nil {
+		return err
+	}
+	tree.Clear()
+	tree.Root, tree.size, tree.m = loaded.Root, loaded.size, loaded.m
+	return nil
+}
@@ -0,0 +1,81 @@
+package btree
+
+import "fmt"
+
+// Validate checks the structural invariants of the tree and returns an error describing the first violation found,
+// or nil if the tree is a valid B-tree.
+//
+// Checked are the number of entries and children of every node against the tree's order, that all leaves are
+// on the same level, the parent pointers, the order of the keys and the tree's size.
+// Validation visits every node, so it is meant for tests and debugging.
+func (tree *Tree) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("btree: empty tree has size %v", tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("btree: root %v has a parent", entryKeys(tree.Root))
+	}
+	var previous *Entry
+	size, leafDepth := 0, -1
+	var validate func(node *Node, depth int) error
+	validate = func(node *Node, depth int) error {
+		if len(node.Entries) > tree.maxEntries() {
+			return fmt.Errorf("btree: node %v has %v entries, at most %v allowed", entryKeys(node), len(node.Entries), tree.maxEntries())
+		}
+		if node != tree.Root && len(node.Entries) < tree.minEntries() {
+			return fmt.Errorf("btree: node %v has %v entries, at least %v required", entryKeys(node), len(node.Entries), tree.minEntries())
+		}
+		if len(node.Entries) == 0 {
+			return fmt.Errorf("btree: node at depth %v has no entries", depth)
+		}
+		if tree.isLeaf(node) {
+			if leafDepth == -1 {
+				leafDepth = depth
+			} else if leafDepth != depth {
+				return fmt.Errorf("btree: leaf %v is at depth %v, other leaves at depth %v", entryKeys(node), depth, leafDepth)
+			}
+		} else if len(node.Children) != len(node.Entries)+1 {
+			return fmt.Errorf("btree: node %v has %v entries but %v children", entryKeys(node), len(node.Entries), len(node.Children))
+		}
+		for i := 0; i <= len(node.Entries); i++ {
+			if i < len(node.Children) {
+				child := node.Children[i]
+				if child.Parent != node {
+					return fmt.Errorf("btree: child %v of node %v has a wrong parent", entryKeys(child), entryKeys(node))
+				}
+				if err := validate(child, depth+1); err != nil {
+					return err
+				}
+			}
+			if i == len(node.Entries) {
+				break
+			}
+			entry := node.Entries[i]
+			if previous != nil && tree.Comparator(previous.Key, entry.Key) >= 0 {
+				return fmt.Errorf("btree: key %v is not smaller than its successor %v", previous.Key, entry.Key)
+			}
+			previous = entry
+			size++
+		}
+		return nil
+	}
+	if err := validate(tree.Root, 0); err != nil {
+		return err
+	}
+	if size != tree.size {
+		return fmt.Errorf("btree: tree has size %v but holds %v entries", tree.size, size)
+	}
+	return nil
+}
+
+// entryKeys returns the keys of the node's entries for error messages.
+func entryKeys(node *Node) []any {
+	keys := make([]any, len(node.Entries))
+	for i, entry := range node.Entries {
+		keys[i] = entry.Key
+	}
+	return keys
+}
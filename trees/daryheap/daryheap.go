@@ -0,0 +1,193 @@
+// Package daryheap implements a d-ary heap backed by a slice.
+//
+// A d-ary heap generalizes the binary heap to nodes with up to d children, which makes it shallower:
+// pushing and changing an element up the heap takes O(log_d n) comparisons, while popping takes O(d log_d n).
+// Higher arities favour workloads with many more pushes than pops and improve cache locality.
+//
+// Comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/D-ary_heap
+package daryheap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Arafatk/Dataviz/trees"
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/trees/heaps"
+)
+
+var _ trees.Tree = (*Heap)(nil)
+var _ heaps.Heap = (*Heap)(nil)
+
+// Heap holds elements in a slice
+type Heap struct {
+	values     []any
+	d          int
+	Comparator utils.Comparator
+}
+
+// NewWith instantiates a new empty heap with the arity d and the custom comparator.
+// Panics if the arity is less than 2.
+func NewWith(d int, comparator utils.Comparator) *Heap {
+	if d < 2 {
+		panic("Invalid arity, should be at least 2")
+	}
+	return &Heap{d: d, Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates a new empty heap with the arity d and the IntComparator, i.e. elements are of type int.
+func NewWithIntComparator(d int) *Heap {
+	return NewWith(d, utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a new empty heap with the arity d and the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator(d int) *Heap {
+	return NewWith(d, utils.StringComparator)
+}
+
+// Push adds values onto the heap and bubbles them up accordingly.
+// Several values are added at once and the heap is rebuilt bottom-up in linear time.
+func (heap *Heap) Push(values ...any) {
+	if len(values) == 1 {
+		heap.values = append(heap.values, values[0])
+		heap.bubbleUp(len(heap.values) - 1)
+	} else {
+		heap.values = append(heap.values, values...)
+		heap.heapify()
+	}
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap) Pop() (value any, ok bool) {
+	if len(heap.values) == 0 {
+		return nil, false
+	}
+	value = heap.values[0]
+	lastIndex := len(heap.values) - 1
+	heap.values[0] = heap.values[lastIndex]
+	heap.values[lastIndex] = nil
+	heap.values = heap.values[:lastIndex]
+	heap.bubbleDown(0)
+	return value, true
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap) Peek() (value any, ok bool) {
+	if len(heap.values) == 0 {
+		return nil, false
+	}
+	return heap.values[0], true
+}
+
+// Arity returns the maximum number of children of every element of the heap.
+func (heap *Heap) Arity() int {
+	return heap.d
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap) Empty() bool {
+	return len(heap.values) == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap) Size() int {
+	return len(heap.values)
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap) Clear() {
+	heap.values = nil
+}
+
+// Values returns all elements in the heap.
+func (heap *Heap) Values() []any {
+	values := make([]any, len(heap.values))
+	copy(values, heap.values)
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap) String() string {
+	str := "DaryHeap\n"
+	values := []string{}
+	for _, value := range heap.values {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Visualizer makes a visual image demonstrating the heap data structure
+// using dot language and Graphviz. Every element is connected to its up to d children.
+func (heap *Heap) Visualizer(fileName string) bool {
+	return utils.WriteDotStringToPng(fileName, heap.dotString())
+}
+
+func (heap *Heap) dotString() string {
+	dotString := "digraph graphname{bgcolor=white;"
+	for index, value := range heap.values {
+		if index != 0 {
+			dotString += strconv.Itoa(heap.parent(index)) + " -> " + strconv.Itoa(index) + ";"
+		}
+		dotString += strconv.Itoa(index) + "[color=steelblue1, style=filled, fillcolor = steelblue1, fontcolor=white,label=" + strconv.Quote(fmt.Sprintf("%v", value)) + "];"
+	}
+	return dotString + "}"
+}
+
+// Restores the min/max-heap order property of all elements by bubbling down every parent,
+// starting from the last one, which takes linear time.
+func (heap *Heap) heapify() {
+	if len(heap.values) < 2 {
+		return
+	}
+	for index := heap.parent(len(heap.values) - 1); index >= 0; index-- {
+		heap.bubbleDown(index)
+	}
+}
+
+// Performs the "bubble down" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap) bubbleDown(index int) {
+	size := len(heap.values)
+	for {
+		firstChild := index*heap.d + 1
+		if firstChild >= size {
+			return
+		}
+		smallestIndex := firstChild
+		for child := firstChild + 1; child < firstChild+heap.d && child < size; child++ {
+			if heap.Comparator(heap.values[child], heap.values[smallestIndex]) < 0 {
+				smallestIndex = child
+			}
+		}
+		if heap.Comparator(heap.values[index], heap.values[smallestIndex]) <= 0 {
+			return
+		}
+		heap.values[index], heap.values[smallestIndex] = heap.values[smallestIndex], heap.values[index]
+		index = smallestIndex
+	}
+}
+
+// Performs the "bubble up" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap) bubbleUp(index int) {
+	for index > 0 {
+		parentIndex := heap.parent(index)
+		if heap.Comparator(heap.values[parentIndex], heap.values[index]) <= 0 {
+			return
+		}
+		heap.values[index], heap.values[parentIndex] = heap.values[parentIndex], heap.values[index]
+		index = parentIndex
+	}
+}
+
+func (heap *Heap) parent(index int) int {
+	return (index - 1) / heap.d
+}
@@ -0,0 +1,269 @@
+package daryheap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+)
+
+func TestDaryHeapPush(t *testing.T) {
+	heap := NewWithIntComparator(3)
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3) // [3]
+	heap.Push(2) // [2,3]
+	heap.Push(4) // [2,3,4]
+	heap.Push(1) // [1,3,4,2](1 is a child of 2, hence swapped with it)
+
+	if actualValue, expectedValue := fmt.Sprint(heap.Values()), "[1 3 4 2]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := heap.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := heap.Arity(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestDaryHeapPop(t *testing.T) {
+	for _, d := range []int{2, 3, 4, 8} {
+		heap := NewWithIntComparator(d)
+		values := rand.Perm(100)
+		for _, value := range values {
+			heap.Push(value)
+			if err := heap.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for expectedValue := 0; expectedValue < 100; expectedValue++ {
+			if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+				t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+			}
+			if err := heap.Validate(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if actualValue, ok := heap.Pop(); actualValue != nil || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+		if actualValue, ok := heap.Peek(); actualValue != nil || ok {
+			t.Errorf("Got %v expected %v", actualValue, nil)
+		}
+	}
+}
+
+func TestDaryHeapPushBulk(t *testing.T) {
+	heap := NewWithIntComparator(4)
+	heap.Push(15, 20, 3, 1, 2, 9, 8, 7, 6, 5, 4)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	heap.Push(0, 30)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	popped := []any{}
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		popped = append(popped, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[0 1 2 3 4 5 6 7 8 9 15 20 30]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDaryHeapInvalidArity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected a panic for an arity of 1")
+		}
+	}()
+	NewWithIntComparator(1)
+}
+
+func TestDaryHeapValidate(t *testing.T) {
+	heap := NewWithIntComparator(3)
+	heap.Push(1, 2, 3, 4)
+	heap.values[3] = 0
+	if err := heap.Validate(); err == nil || !strings.Contains(err.Error(), "index 3") {
+		t.Errorf("Got %v expected a heap order violation", err)
+	}
+}
+
+func TestDaryHeapIterator(t *testing.T) {
+	heap := NewWithStringComparator(3)
+	heap.Push("c", "b", "a")
+
+	it := heap.Iterator()
+	actual := []string{}
+	for it.Next() {
+		actual = append(actual, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:a 1:b 2:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	actual = actual[:0]
+	for it.Prev() {
+		actual = append(actual, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:c 1:b 0:a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it.Last(); it.Value() != "c" {
+		t.Errorf("Got %v expected %v", it.Value(), "c")
+	}
+	if it.First(); it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Value(), "a")
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(heap.SeqValues())), "[a b c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestDaryHeapSerialization(t *testing.T) {
+	heap := NewWithStringComparator(3)
+	heap.Push("d", "c", "b", "a")
+
+	data, err := heap.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `["a","c","b","d"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := heap.FromJSON([]byte(`["z","y","x"]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != "x" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "x")
+	}
+
+	type document struct {
+		Heap *Heap
+	}
+	data, err = json.Marshal(document{Heap: heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := document{Heap: NewWithStringComparator(3)}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Heap.Values()), fmt.Sprint(heap.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a heap without a comparator")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(heap); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithStringComparator(2)
+	if err := gob.NewDecoder(&buffer).Decode(loaded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := loaded.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, ok := loaded.Pop(); actualValue != "x" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "x")
+	}
+}
+
+func TestDaryHeapRandom(t *testing.T) {
+	heap := NewWithIntComparator(5)
+	model := []int{}
+	for i := 0; i < 1000; i++ {
+		if rand.Intn(3) == 0 {
+			value, ok := heap.Pop()
+			sort.Ints(model)
+			if ok != (len(model) > 0) || (ok && value != model[0]) {
+				t.Fatalf("Got %v, %v", value, ok)
+			}
+			if ok {
+				model = model[1:]
+			}
+		} else {
+			value := rand.Intn(100)
+			heap.Push(value)
+			model = append(model, value)
+		}
+	}
+}
+
+func TestDaryHeapDotString(t *testing.T) {
+	heap := NewWithIntComparator(3)
+	heap.Push(1, 2, 3, 4, 5)
+	dotString := heap.dotString()
+	for _, expected := range []string{"0 -> 1;", "0 -> 3;", "1 -> 4;", `label="5"`} {
+		if !strings.Contains(dotString, expected) {
+			t.Errorf("Got %v expected it to contain %v", dotString, expected)
+		}
+	}
+}
+
+func FuzzDaryHeap(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, d := range []int{2, 3, 4} {
+			if err := containertest.CheckHeap(NewWithIntComparator(d), containertest.Decode(data)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	})
+}
+
+func benchmarkPush(b *testing.B, heap *Heap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, heap *Heap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkDaryHeapPush100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	heap := NewWith(4, utils.IntComparator)
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
+
+func BenchmarkDaryHeapPop100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	heap := NewWith(4, utils.IntComparator)
+	for n := 0; n < size; n++ {
+		heap.Push(rand.Intn(size))
+	}
+	b.StartTimer()
+	benchmarkPop(b, heap, size)
+}
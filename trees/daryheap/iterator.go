@@ -0,0 +1,11 @@
+package daryheap
+
+import "github.com/riadafridishibly/DataViz/trees/heaps"
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+type Iterator = heaps.SliceIterator
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (heap *Heap) Iterator() Iterator {
+	return heaps.NewSliceIterator(func() []any { return heap.values })
+}
@@ -0,0 +1,16 @@
+package daryheap
+
+import "fmt"
+
+// Validate checks the heap order and returns an error describing the first violation found,
+// or nil if no element is ordered before its parent by the heap's comparator.
+// Validation visits every element, so it is meant for tests and debugging.
+func (heap *Heap) Validate() error {
+	for index := 1; index < len(heap.values); index++ {
+		parentIndex := heap.parent(index)
+		if heap.Comparator(heap.values[parentIndex], heap.values[index]) > 0 {
+			return fmt.Errorf("daryheap: element %v at index %v is ordered before its parent %v at index %v", heap.values[index], index, heap.values[parentIndex], parentIndex)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+// Package heaps provides an abstract Heap interface.
+//
+// In computer science, a heap is a tree-based data structure that keeps its elements in heap order:
+// no element is ordered before its parent by the heap's comparator, so the element ordered first is always at the top.
+// Its principal operations are push, which adds an element, and pop, which removes the element at the top.
+// Additionally, a peek operation gives access to the top without modifying the heap.
+//
+// Reference: https://en.wikipedia.org/wiki/Heap_(data_structure)
+package heaps
+
+import "github.com/Arafatk/Dataviz/containers"
+
+// Heap interface that all heaps implement
+type Heap interface {
+	Push(values ...any)
+	Pop() (value any, ok bool)
+	Peek() (value any, ok bool)
+
+	containers.Container
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+}
@@ -0,0 +1,49 @@
+package heaps
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/Arafatk/Dataviz/utils"
+)
+
+func TestSliceIterator(t *testing.T) {
+	values := []any{"a", "b"}
+	it := NewSliceIterator(func() []any { return values })
+	if actualValue := it.Prev(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	values = append(values, "c")
+	actual := []any{}
+	for it.Begin(); it.Next(); {
+		actual = append(actual, fmt.Sprintf("%v:%v", it.Index(), it.Value()))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:a 1:b 2:c]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if !it.Last() || it.Value() != "c" || !it.First() || it.Value() != "a" {
+		t.Errorf("Got %v expected %v", it.Value(), "a")
+	}
+}
+
+func TestSerialization(t *testing.T) {
+	data, err := ToJSON(slices.Values([]any{"a", 1.5}))
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	var loaded []any
+	load := func(values []any) { loaded = values }
+	if err := UnmarshalJSON(data, utils.StringComparator, load); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(loaded), "[a 1.5]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := UnmarshalJSON(data, nil, load); err != ErrNoComparator {
+		t.Errorf("Got %v expected %v", err, ErrNoComparator)
+	}
+	if err := UnmarshalBinary(nil, nil, load); err != ErrNoComparator {
+		t.Errorf("Got %v expected %v", err, ErrNoComparator)
+	}
+}
@@ -0,0 +1,84 @@
+package heaps
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.ReverseIteratorWithIndex = (*SliceIterator)(nil)
+
+// SliceIterator is a stateful iterator over the elements of a slice-backed heap in the order of its underlying slice,
+// whose values can be fetched by an index.
+type SliceIterator struct {
+	values func() []any
+	index  int
+}
+
+// NewSliceIterator returns a stateful iterator over the slice returned by values, which is called on every step
+// so the iterator sees the heap's current slice.
+func NewSliceIterator(values func() []any) SliceIterator {
+	return SliceIterator{values: values, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *SliceIterator) Next() bool {
+	if iterator.index < len(iterator.values()) {
+		iterator.index++
+	}
+	return iterator.withinRange()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *SliceIterator) Prev() bool {
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.withinRange()
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *SliceIterator) Value() any {
+	return iterator.values()[iterator.index]
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *SliceIterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *SliceIterator) Begin() {
+	iterator.index = -1
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *SliceIterator) End() {
+	iterator.index = len(iterator.values())
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *SliceIterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *SliceIterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// Check that the index is within bounds of the heap
+func (iterator *SliceIterator) withinRange() bool {
+	return iterator.index >= 0 && iterator.index < len(iterator.values())
+}
@@ -0,0 +1,58 @@
+package heaps
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"iter"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/binarycodec"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+)
+
+// ErrNoComparator is returned when decoding into a heap without a comparator.
+var ErrNoComparator = errors.New("heaps: heap has no comparator")
+
+// ToJSON returns the JSON array of a heap's elements in the order of the sequence.
+func ToJSON(values iter.Seq[any]) ([]byte, error) {
+	var buffer bytes.Buffer
+	if err := jsonstream.WriteArray(&buffer, values); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON decodes the JSON array of a heap's elements and passes them to load, which replaces the heap's elements.
+// Returns ErrNoComparator if the comparator is nil, as the heap cannot be restored without one.
+func UnmarshalJSON(data []byte, comparator utils.Comparator, load func(values []any)) error {
+	if comparator == nil {
+		return ErrNoComparator
+	}
+	return ReadJSON(bytes.NewReader(data), load)
+}
+
+// ReadJSON reads the JSON array of a heap's elements from the reader and passes them to load, which replaces the heap's elements.
+func ReadJSON(r io.Reader, load func(values []any)) error {
+	values, err := jsonstream.ReadArray[any](r)
+	if err != nil {
+		return err
+	}
+	load(values)
+	return nil
+}
+
+// UnmarshalBinary decodes the binary representation of a heap's elements, see the binarycodec package,
+// and passes them to load, which replaces the heap's elements.
+// Returns ErrNoComparator if the comparator is nil, as the heap cannot be restored without one.
+func UnmarshalBinary(data []byte, comparator utils.Comparator, load func(values []any)) error {
+	if comparator == nil {
+		return ErrNoComparator
+	}
+	values, err := binarycodec.Unmarshal[any](data)
+	if err != nil {
+		return err
+	}
+	load(values)
+	return nil
+}
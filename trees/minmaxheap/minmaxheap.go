@@ -0,0 +1,276 @@
+// Package minmaxheap implements a min-max heap backed by a slice.
+//
+// A min-max heap is a complete binary tree whose levels alternate between min and max levels, starting with a min level at the root:
+// every element on a min level is ordered before or equal to all of its descendants, every element on a max level after or equal to them.
+// Hence the first element is at the root and the last element is one of its children,
+// which gives constant-time access to both ends and logarithmic-time removal of either of them, making it a double-ended priority queue.
+//
+// Comparator defines the order of the elements, the first one being the minimum.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Min-max_heap
+package minmaxheap
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+
+	"github.com/Arafatk/Dataviz/trees"
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/trees/heaps"
+)
+
+var _ trees.Tree = (*Heap)(nil)
+var _ heaps.Heap = (*Heap)(nil)
+
+// Heap holds elements in a slice
+type Heap struct {
+	values     []any
+	Comparator utils.Comparator
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith(comparator utils.Comparator) *Heap {
+	return &Heap{Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates a new empty heap with the IntComparator, i.e. elements are of type int.
+func NewWithIntComparator() *Heap {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator() *Heap {
+	return NewWith(utils.StringComparator)
+}
+
+// Push adds values onto the heap and bubbles them up accordingly.
+// Several values are added at once and the heap is rebuilt bottom-up in linear time.
+func (heap *Heap) Push(values ...any) {
+	if len(values) == 1 {
+		heap.values = append(heap.values, values[0])
+		heap.bubbleUp(len(heap.values) - 1)
+	} else {
+		heap.values = append(heap.values, values...)
+		heap.heapify()
+	}
+}
+
+// Pop removes the minimum element of the heap and returns it, or nil if heap is empty, same as PopMin.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap) Pop() (value any, ok bool) {
+	return heap.PopMin()
+}
+
+// Peek returns the minimum element of the heap without removing it, or nil if heap is empty, same as PeekMin.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap) Peek() (value any, ok bool) {
+	return heap.PeekMin()
+}
+
+// PopMin removes the minimum element of the heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap) PopMin() (value any, ok bool) {
+	if len(heap.values) == 0 {
+		return nil, false
+	}
+	return heap.removeIndex(0), true
+}
+
+// PopMax removes the maximum element of the heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap) PopMax() (value any, ok bool) {
+	if len(heap.values) == 0 {
+		return nil, false
+	}
+	return heap.removeIndex(heap.maxIndex()), true
+}
+
+// PeekMin returns the minimum element of the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap) PeekMin() (value any, ok bool) {
+	if len(heap.values) == 0 {
+		return nil, false
+	}
+	return heap.values[0], true
+}
+
+// PeekMax returns the maximum element of the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap) PeekMax() (value any, ok bool) {
+	if len(heap.values) == 0 {
+		return nil, false
+	}
+	return heap.values[heap.maxIndex()], true
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap) Empty() bool {
+	return len(heap.values) == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap) Size() int {
+	return len(heap.values)
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap) Clear() {
+	heap.values = nil
+}
+
+// Values returns all elements in the heap.
+func (heap *Heap) Values() []any {
+	values := make([]any, len(heap.values))
+	copy(values, heap.values)
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap) String() string {
+	str := "MinMaxHeap\n"
+	values := []string{}
+	for _, value := range heap.values {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Visualizer makes a visual image demonstrating the heap data structure
+// using dot language and Graphviz. Elements on min levels are drawn in blue, elements on max levels in red.
+func (heap *Heap) Visualizer(fileName string) bool {
+	return utils.WriteDotStringToPng(fileName, heap.dotString())
+}
+
+func (heap *Heap) dotString() string {
+	dotString := "digraph graphname{bgcolor=white;"
+	for index, value := range heap.values {
+		if index != 0 {
+			dotString += strconv.Itoa((index-1)/2) + " -> " + strconv.Itoa(index) + ";"
+		}
+		color := "steelblue1"
+		if !onMinLevel(index) {
+			color = "indianred1"
+		}
+		dotString += strconv.Itoa(index) + "[color=" + color + ", style=filled, fillcolor = " + color + ", fontcolor=white,label=" + strconv.Quote(fmt.Sprintf("%v", value)) + "];"
+	}
+	return dotString + "}"
+}
+
+// maxIndex returns the index of the maximum element of a non-empty heap, which is the root or one of its children.
+func (heap *Heap) maxIndex() int {
+	switch len(heap.values) {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	}
+	if heap.Comparator(heap.values[1], heap.values[2]) >= 0 {
+		return 1
+	}
+	return 2
+}
+
+// removeIndex removes the element at the index by replacing it with the last one, which is then trickled down accordingly.
+func (heap *Heap) removeIndex(index int) any {
+	value := heap.values[index]
+	lastIndex := len(heap.values) - 1
+	heap.values[index] = heap.values[lastIndex]
+	heap.values[lastIndex] = nil
+	heap.values = heap.values[:lastIndex]
+	if index < lastIndex {
+		heap.bubbleDown(index)
+	}
+	return value
+}
+
+// Restores the min-max heap order property of all elements by trickling down every parent,
+// starting from the last one, which takes linear time.
+func (heap *Heap) heapify() {
+	for index := len(heap.values)/2 - 1; index >= 0; index-- {
+		heap.bubbleDown(index)
+	}
+}
+
+// Performs the "trickle down" operation. This is to place the element that is at the index
+// in its correct place among its descendants so that the heap maintains the min-max heap order property.
+func (heap *Heap) bubbleDown(index int) {
+	// on a min level, an element has to be ordered before its descendants, on a max level after them
+	sign := 1
+	if !onMinLevel(index) {
+		sign = -1
+	}
+	for {
+		m, isGrandchild := heap.extremeDescendant(index, sign)
+		if m < 0 || sign*heap.Comparator(heap.values[m], heap.values[index]) >= 0 {
+			return
+		}
+		heap.swap(m, index)
+		if !isGrandchild {
+			return
+		}
+		// the swapped element may be out of order with the grandchild's parent, which is on the opposite level
+		if parent := (m - 1) / 2; sign*heap.Comparator(heap.values[m], heap.values[parent]) > 0 {
+			heap.swap(m, parent)
+		}
+		index = m
+	}
+}
+
+// extremeDescendant returns the index of the child or grandchild of the element at the index that is ordered first,
+// or last if sign is negative, and whether it is a grandchild. Returns -1 if the element has no children.
+func (heap *Heap) extremeDescendant(index int, sign int) (int, bool) {
+	size := len(heap.values)
+	extreme, isGrandchild := -1, false
+	firstChild := 2*index + 1
+	for child := firstChild; child < firstChild+2 && child < size; child++ {
+		if extreme < 0 || sign*heap.Comparator(heap.values[child], heap.values[extreme]) < 0 {
+			extreme, isGrandchild = child, false
+		}
+		firstGrandchild := 2*child + 1
+		for grandchild := firstGrandchild; grandchild < firstGrandchild+2 && grandchild < size; grandchild++ {
+			if sign*heap.Comparator(heap.values[grandchild], heap.values[extreme]) < 0 {
+				extreme, isGrandchild = grandchild, true
+			}
+		}
+	}
+	return extreme, isGrandchild
+}
+
+// Performs the "bubble up" operation. This is to place the element that is at the index
+// in its correct place among its ancestors so that the heap maintains the min-max heap order property.
+func (heap *Heap) bubbleUp(index int) {
+	if index == 0 {
+		return
+	}
+	sign := 1
+	if !onMinLevel(index) {
+		sign = -1
+	}
+	// an element ordered wrongly against its parent, which is on the opposite level, moves to the parent's levels
+	if parent := (index - 1) / 2; sign*heap.Comparator(heap.values[index], heap.values[parent]) > 0 {
+		heap.swap(index, parent)
+		index, sign = parent, -sign
+	}
+	for index > 2 {
+		grandparent := ((index-1)/2 - 1) / 2
+		if sign*heap.Comparator(heap.values[index], heap.values[grandparent]) >= 0 {
+			return
+		}
+		heap.swap(index, grandparent)
+		index = grandparent
+	}
+}
+
+func (heap *Heap) swap(i, j int) {
+	heap.values[i], heap.values[j] = heap.values[j], heap.values[i]
+}
+
+// onMinLevel returns true if the element at the index is on a min level, i.e. an even level counted from the root.
+func onMinLevel(index int) bool {
+	return (bits.Len(uint(index+1))-1)%2 == 0
+}
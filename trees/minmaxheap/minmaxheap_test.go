@@ -0,0 +1,272 @@
+package minmaxheap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+)
+
+func TestMinMaxHeapPush(t *testing.T) {
+	heap := NewWithIntComparator()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	heap.Push(3)
+	if actualValue, ok := heap.PeekMax(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	heap.Push(1)
+	heap.Push(5)
+	heap.Push(4)
+	heap.Push(2)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, ok := heap.PeekMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue := heap.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+func TestMinMaxHeapPopBothEnds(t *testing.T) {
+	heap := NewWithIntComparator()
+	for _, value := range rand.Perm(100) {
+		heap.Push(value)
+	}
+	for low, high := 0, 99; low <= high; low, high = low+1, high-1 {
+		if actualValue, ok := heap.PopMax(); actualValue != high || !ok {
+			t.Fatalf("Got %v expected %v", actualValue, high)
+		}
+		if err := heap.Validate(); err != nil {
+			t.Fatal(err)
+		}
+		if actualValue, ok := heap.PopMin(); actualValue != low || !ok {
+			t.Fatalf("Got %v expected %v", actualValue, low)
+		}
+		if err := heap.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.PopMin(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMinMaxHeapPushBulk(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(15, 20, 3, 1, 2, 9, 8, 7, 6, 5, 4)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, _ := heap.PeekMax(); actualValue != 20 {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	popped := []any{}
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		popped = append(popped, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[1 2 3 4 5 6 7 8 9 15 20]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMinMaxHeapRandom(t *testing.T) {
+	heap := NewWithIntComparator()
+	model := []int{}
+	for i := 0; i < 3000; i++ {
+		switch rand.Intn(4) {
+		case 0:
+			value, ok := heap.PopMin()
+			sort.Ints(model)
+			if ok != (len(model) > 0) || (ok && value != model[0]) {
+				t.Fatalf("Got %v, %v", value, ok)
+			}
+			if ok {
+				model = model[1:]
+			}
+		case 1:
+			value, ok := heap.PopMax()
+			sort.Ints(model)
+			if ok != (len(model) > 0) || (ok && value != model[len(model)-1]) {
+				t.Fatalf("Got %v, %v", value, ok)
+			}
+			if ok {
+				model = model[:len(model)-1]
+			}
+		default:
+			value := rand.Intn(100)
+			heap.Push(value)
+			model = append(model, value)
+		}
+		if err := heap.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestMinMaxHeapValidate(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(1, 2, 3, 4, 5, 6, 7)
+	heap.values[3] = 100
+	if err := heap.Validate(); err == nil || !strings.Contains(err.Error(), "max level") {
+		t.Errorf("Got %v expected a heap order violation", err)
+	}
+	heap.Clear()
+	heap.Push(1, 2, 3, 4, 5, 6, 7)
+	heap.values[6] = 0
+	if err := heap.Validate(); err == nil || !strings.Contains(err.Error(), "min level") {
+		t.Errorf("Got %v expected a heap order violation", err)
+	}
+}
+
+func TestMinMaxHeapIterator(t *testing.T) {
+	heap := NewWithStringComparator()
+	heap.Push("a", "c", "b")
+
+	actual := []string{}
+	for index, value := range heap.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[0:a 1:c 2:b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	actual = actual[:0]
+	for index, value := range heap.Backward() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := fmt.Sprint(actual), "[2:b 1:c 0:a]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if it := heap.Iterator(); !it.Last() || it.Value() != "b" {
+		t.Errorf("Got %v expected %v", it.Value(), "b")
+	}
+}
+
+func TestMinMaxHeapSerialization(t *testing.T) {
+	heap := NewWithStringComparator()
+	heap.Push("a", "c", "b")
+
+	data, err := heap.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `["a","c","b"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := heap.FromJSON([]byte(`["w","x","y","z"]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != "z" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "z")
+	}
+
+	type document struct {
+		Heap *Heap
+	}
+	data, err = json.Marshal(document{Heap: heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := document{Heap: NewWithStringComparator()}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(decoded.Heap.Values()), fmt.Sprint(heap.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a heap without a comparator")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(heap); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithStringComparator()
+	if err := gob.NewDecoder(&buffer).Decode(loaded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := fmt.Sprint(slices.Collect(loaded.SeqValues())), fmt.Sprint(heap.Values()); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMinMaxHeapDotString(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(1, 2, 3, 4)
+	dotString := heap.dotString()
+	for _, expected := range []string{"0 -> 1;", "1 -> 3;", `0[color=steelblue1`, `1[color=indianred1`, `3[color=steelblue1`} {
+		if !strings.Contains(dotString, expected) {
+			t.Errorf("Got %v expected it to contain %v", dotString, expected)
+		}
+	}
+}
+
+func FuzzMinMaxHeap(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckHeap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func benchmarkPush(b *testing.B, heap *Heap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPopMax(b *testing.B, heap *Heap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.PopMax()
+		}
+	}
+}
+
+func BenchmarkMinMaxHeapPush100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	heap := NewWith(utils.IntComparator)
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
+
+func BenchmarkMinMaxHeapPopMax100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	heap := NewWith(utils.IntComparator)
+	for n := 0; n < size; n++ {
+		heap.Push(rand.Intn(size))
+	}
+	b.StartTimer()
+	benchmarkPopMax(b, heap, size)
+}
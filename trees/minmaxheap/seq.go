@@ -0,0 +1,35 @@
+package minmaxheap
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the indices and values of the heap in the order of its underlying array, for use with range.
+func (heap *Heap) Seq() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		for it := heap.Iterator(); it.Next(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqValues returns a sequence of the values of the heap in the order of its underlying array, for use with range.
+func (heap *Heap) SeqValues() iter.Seq[any] {
+	return seq.Values(heap.Seq())
+}
+
+// Backward returns a sequence of the indices and values of the heap in the reverse order of its underlying array, for use with range.
+func (heap *Heap) Backward() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		it := heap.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
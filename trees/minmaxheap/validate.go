@@ -0,0 +1,34 @@
+package minmaxheap
+
+import "fmt"
+
+// Validate checks the min-max heap order and returns an error describing the first violation found,
+// or nil if every element on a min level is ordered before or equal to its descendants
+// and every element on a max level after or equal to them.
+// Validation visits every element, so it is meant for tests and debugging.
+func (heap *Heap) Validate() error {
+	// checking every element against its parent and grandparent suffices, as the order is transitive
+	for index := 1; index < len(heap.values); index++ {
+		parent := (index - 1) / 2
+		if err := heap.validateAncestor(parent, index); err != nil {
+			return err
+		}
+		if parent > 0 {
+			if err := heap.validateAncestor((parent-1)/2, index); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (heap *Heap) validateAncestor(ancestor int, index int) error {
+	comparison := heap.Comparator(heap.values[ancestor], heap.values[index])
+	if onMinLevel(ancestor) && comparison > 0 {
+		return fmt.Errorf("minmaxheap: element %v at index %v is ordered before its ancestor %v at index %v on a min level", heap.values[index], index, heap.values[ancestor], ancestor)
+	}
+	if !onMinLevel(ancestor) && comparison < 0 {
+		return fmt.Errorf("minmaxheap: element %v at index %v is ordered after its ancestor %v at index %v on a max level", heap.values[index], index, heap.values[ancestor], ancestor)
+	}
+	return nil
+}
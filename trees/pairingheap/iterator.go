@@ -0,0 +1,72 @@
+package pairingheap
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.IteratorWithIndex = (*Iterator)(nil)
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+// Elements are visited in pre-order, i.e. every element before its children, so the first element is the top of the heap.
+type Iterator struct {
+	heap    *Heap
+	node    *node
+	pending []*node
+	index   int
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (heap *Heap) Iterator() Iterator {
+	return Iterator{heap: heap, index: -1}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	switch {
+	case iterator.index == -1:
+		iterator.node = iterator.heap.root
+	case iterator.node == nil:
+		return false
+	default:
+		// the children of the current element come before its siblings, which are visited once its subtree is done
+		if iterator.node.sibling != nil {
+			iterator.pending = append(iterator.pending, iterator.node.sibling)
+		}
+		iterator.node = iterator.node.child
+		if iterator.node == nil && len(iterator.pending) > 0 {
+			iterator.node = iterator.pending[len(iterator.pending)-1]
+			iterator.pending = iterator.pending[:len(iterator.pending)-1]
+		}
+	}
+	iterator.index++
+	return iterator.node != nil
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() any {
+	return iterator.node.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.node = nil
+	iterator.pending = iterator.pending[:0]
+	iterator.index = -1
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
@@ -0,0 +1,209 @@
+// Package pairingheap implements a pairing heap.
+//
+// A pairing heap is a heap-ordered multiway tree: no element is ordered before its parent and the element ordered first is at the root.
+// Pushing an element and merging two heaps link two roots in constant time,
+// while popping removes the root and pairs up the forest of its subtrees, which takes O(log n) amortized time.
+//
+// Comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Pairing_heap
+package pairingheap
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Arafatk/Dataviz/trees"
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/trees/heaps"
+)
+
+var _ trees.Tree = (*Heap)(nil)
+var _ heaps.Heap = (*Heap)(nil)
+
+// Heap holds elements in a multiway tree
+type Heap struct {
+	root       *node
+	size       int
+	Comparator utils.Comparator
+}
+
+// node is a single element within the heap, linked to its first child and to its next sibling.
+type node struct {
+	value   any
+	child   *node
+	sibling *node
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith(comparator utils.Comparator) *Heap {
+	return &Heap{Comparator: comparator}
+}
+
+// NewWithIntComparator instantiates a new empty heap with the IntComparator, i.e. elements are of type int.
+func NewWithIntComparator() *Heap {
+	return NewWith(utils.IntComparator)
+}
+
+// NewWithStringComparator instantiates a new empty heap with the StringComparator, i.e. elements are of type string.
+func NewWithStringComparator() *Heap {
+	return NewWith(utils.StringComparator)
+}
+
+// Push adds values onto the heap, linking each of them with the root in constant time.
+func (heap *Heap) Push(values ...any) {
+	for _, value := range values {
+		heap.root = heap.link(heap.root, &node{value: value})
+		heap.size++
+	}
+}
+
+// Pop removes top element on heap and returns it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap) Pop() (value any, ok bool) {
+	if heap.root == nil {
+		return nil, false
+	}
+	value = heap.root.value
+	heap.root = heap.pair(heap.root.child)
+	heap.size--
+	return value, true
+}
+
+// Peek returns top element on the heap without removing it, or nil if heap is empty.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap) Peek() (value any, ok bool) {
+	if heap.root == nil {
+		return nil, false
+	}
+	return heap.root.value, true
+}
+
+// Merge moves all elements of the other heap into the heap in constant time, leaving the other heap empty.
+// Both heaps are expected to order their elements with the same comparator.
+func (heap *Heap) Merge(other *Heap) {
+	if other == heap {
+		return
+	}
+	heap.root = heap.link(heap.root, other.root)
+	heap.size += other.size
+	other.Clear()
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap) Clear() {
+	heap.root = nil
+	heap.size = 0
+}
+
+// Values returns all elements in the heap in pre-order, i.e. every element before its children.
+func (heap *Heap) Values() []any {
+	values := make([]any, 0, heap.size)
+	for it := heap.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap) String() string {
+	str := "PairingHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Visualizer makes a visual image demonstrating the heap data structure
+// using dot language and Graphviz. The root is drawn above the forest of its subtrees,
+// each subtree in its own box, which Pop pairs up into the new heap.
+func (heap *Heap) Visualizer(fileName string) bool {
+	return utils.WriteDotStringToPng(fileName, heap.dotString())
+}
+
+func (heap *Heap) dotString() string {
+	var builder strings.Builder
+	builder.WriteString("digraph graphname{bgcolor=white;")
+	if heap.root != nil {
+		id := 0
+		dotNode(&builder, heap.root, &id)
+		for tree, index := heap.root.child, 0; tree != nil; tree, index = tree.sibling, index+1 {
+			child := id
+			builder.WriteString("subgraph cluster_" + strconv.Itoa(index) + "{color=gray;")
+			dotSubtree(&builder, tree, &id)
+			builder.WriteString("}")
+			builder.WriteString("0 -> " + strconv.Itoa(child) + ";")
+		}
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// dotSubtree writes the node and its descendants with the edges to their children, numbering them in pre-order from id.
+func dotSubtree(builder *strings.Builder, n *node, id *int) {
+	parent := *id
+	dotNode(builder, n, id)
+	for child := n.child; child != nil; child = child.sibling {
+		builder.WriteString(strconv.Itoa(parent) + " -> " + strconv.Itoa(*id) + ";")
+		dotSubtree(builder, child, id)
+	}
+}
+
+// dotNode writes the node numbered id and advances id.
+func dotNode(builder *strings.Builder, n *node, id *int) {
+	builder.WriteString(strconv.Itoa(*id) + "[color=steelblue1, style=filled, fillcolor = steelblue1, fontcolor=white,label=" + strconv.Quote(fmt.Sprintf("%v", n.value)) + "];")
+	*id++
+}
+
+// link makes the root ordered after the other the first child of the other root and returns the root ordered first.
+func (heap *Heap) link(a *node, b *node) *node {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if heap.Comparator(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.sibling = a.child
+	a.child = b
+	return a
+}
+
+// pair links the forest of siblings starting at first into a single tree and returns its root.
+// Siblings are linked in pairs from left to right, then the pairs are linked from right to left into one tree.
+func (heap *Heap) pair(first *node) *node {
+	var pairs []*node
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling = nil
+		}
+		a.sibling = nil
+		pairs = append(pairs, heap.link(a, b))
+	}
+	var root *node
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = heap.link(pairs[i], root)
+	}
+	return root
+}
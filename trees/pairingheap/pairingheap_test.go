@@ -0,0 +1,256 @@
+package pairingheap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/containertest"
+)
+
+func TestPairingHeapPush(t *testing.T) {
+	heap := NewWithIntComparator()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3) // 3
+	heap.Push(2) // 2(3)
+	heap.Push(1) // 1(2(3))
+	heap.Push(4) // 1(4 2(3))
+
+	if actualValue, expectedValue := fmt.Sprint(heap.Values()), "[1 4 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := heap.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestPairingHeapPop(t *testing.T) {
+	heap := NewWithIntComparator()
+	for _, value := range rand.Perm(100) {
+		heap.Push(value)
+	}
+	for expectedValue := 0; expectedValue < 100; expectedValue++ {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if err := heap.Validate(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if actualValue, ok := heap.Pop(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != nil || ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestPairingHeapMerge(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(5, 1, 9)
+	other := NewWithIntComparator()
+	other.Push(4, 0, 8)
+
+	heap.Merge(other)
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue := other.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	heap.Merge(heap)
+	heap.Merge(NewWithIntComparator())
+	if actualValue := heap.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	popped := []any{}
+	for value, ok := heap.Pop(); ok; value, ok = heap.Pop() {
+		popped = append(popped, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[0 1 4 5 8 9]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	other.Push(2)
+	heap.Merge(other)
+	if actualValue, ok := heap.Peek(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestPairingHeapValidate(t *testing.T) {
+	heap := NewWithIntComparator()
+	heap.Push(1, 2, 3)
+	heap.root.child.value = 0
+	if err := heap.Validate(); err == nil || !strings.Contains(err.Error(), "ordered before its parent") {
+		t.Errorf("Got %v expected a heap order violation", err)
+	}
+	heap.Clear()
+	heap.Push(1, 2, 3)
+	heap.size++
+	if err := heap.Validate(); err == nil || !strings.Contains(err.Error(), "size") {
+		t.Errorf("Got %v expected a size violation", err)
+	}
+}
+
+func TestPairingHeapIterator(t *testing.T) {
+	heap := NewWithIntComparator()
+	it := heap.Iterator()
+	if actualValue := it.Next(); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+
+	heap.Push(5, 3, 7, 1, 6)
+	for heap.Size() > 4 {
+		heap.Pop() // pairs up the subtrees into a deeper tree
+	}
+	actual := []string{}
+	for index, value := range heap.Seq() {
+		actual = append(actual, fmt.Sprintf("%v:%v", index, value))
+	}
+	if actualValue, expectedValue := len(actual), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := actual[0], "0:3"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	values := slices.Collect(heap.SeqValues())
+	slices.SortFunc(values, utils.IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(values), "[3 5 6 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it = heap.Iterator()
+	for it.Next() {
+	}
+	if actualValue := it.First(); actualValue != true || it.Value() != 3 || it.Index() != 0 {
+		t.Errorf("Got %v %v expected %v", actualValue, it.Value(), 3)
+	}
+}
+
+func TestPairingHeapSerialization(t *testing.T) {
+	heap := NewWithStringComparator()
+	heap.Push("c", "b", "a")
+
+	data, err := heap.ToJSON()
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := string(data), `["a","b","c"]`; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := heap.FromJSON([]byte(`["z","x","y"]`)); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if err := heap.Validate(); err != nil {
+		t.Error(err)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != "x" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "x")
+	}
+
+	type document struct {
+		Heap *Heap
+	}
+	data, err = json.Marshal(document{Heap: heap})
+	if err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	decoded := document{Heap: NewWithStringComparator()}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	if actualValue, expectedValue := decoded.Heap.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if err := json.Unmarshal(data, &document{}); err == nil {
+		t.Errorf("Expected error for a heap without a comparator")
+	}
+
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(heap); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	loaded := NewWithStringComparator()
+	if err := gob.NewDecoder(&buffer).Decode(loaded); err != nil {
+		t.Errorf("Got error %v", err)
+	}
+	popped := []any{}
+	for value, ok := loaded.Pop(); ok; value, ok = loaded.Pop() {
+		popped = append(popped, value)
+	}
+	if actualValue, expectedValue := fmt.Sprint(popped), "[x y z]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestPairingHeapDotString(t *testing.T) {
+	heap := NewWithIntComparator()
+	if actualValue, expectedValue := heap.dotString(), "digraph graphname{bgcolor=white;}"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	heap.Push(3, 2, 1, 4) // 1(4 2(3))
+	dotString := heap.dotString()
+	for _, expected := range []string{`0[color=steelblue1, style=filled, fillcolor = steelblue1, fontcolor=white,label="1"]`, "subgraph cluster_0{", "subgraph cluster_1{", "0 -> 1;", "0 -> 2;", "2 -> 3;"} {
+		if !strings.Contains(dotString, expected) {
+			t.Errorf("Got %v expected it to contain %v", dotString, expected)
+		}
+	}
+}
+
+func FuzzPairingHeap(f *testing.F) {
+	containertest.AddSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := containertest.CheckHeap(NewWithIntComparator(), containertest.Decode(data)); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func benchmarkPush(b *testing.B, heap *Heap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, heap *Heap, size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkPairingHeapPush100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	heap := NewWith(utils.IntComparator)
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
+
+func BenchmarkPairingHeapPop100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	heap := NewWith(utils.IntComparator)
+	for n := 0; n < size; n++ {
+		heap.Push(rand.Intn(size))
+	}
+	b.StartTimer()
+	benchmarkPop(b, heap, size)
+}
@@ -0,0 +1,23 @@
+package pairingheap
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the indices and values of the heap in pre-order, for use with range.
+func (heap *Heap) Seq() iter.Seq2[int, any] {
+	return func(yield func(index int, value any) bool) {
+		for it := heap.Iterator(); it.Next(); {
+			if !yield(it.Index(), it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// SeqValues returns a sequence of the values of the heap in pre-order, for use with range.
+func (heap *Heap) SeqValues() iter.Seq[any] {
+	return seq.Values(heap.Seq())
+}
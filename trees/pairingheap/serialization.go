@@ -0,0 +1,85 @@
+package pairingheap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/binarycodec"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+	"github.com/riadafridishibly/DataViz/trees/heaps"
+)
+
+var _ containers.JSONSerializer = (*Heap)(nil)
+var _ containers.JSONDeserializer = (*Heap)(nil)
+var _ containers.BinarySerializer = (*Heap)(nil)
+var _ containers.BinaryDeserializer = (*Heap)(nil)
+var _ gob.GobEncoder = (*Heap)(nil)
+var _ gob.GobDecoder = (*Heap)(nil)
+var _ json.Marshaler = (*Heap)(nil)
+var _ json.Unmarshaler = (*Heap)(nil)
+
+// ToJSON outputs the JSON representation of the heap's elements in pre-order.
+func (heap *Heap) ToJSON() ([]byte, error) {
+	return heaps.ToJSON(heap.SeqValues())
+}
+
+// FromJSON populates the heap's elements from the input JSON representation.
+// The elements may be in any order, they are pushed onto the heap one at a time in constant time each.
+func (heap *Heap) FromJSON(data []byte) error {
+	return heaps.ReadJSON(bytes.NewReader(data), heap.load)
+}
+
+// MarshalJSON outputs the JSON representation of the heap's elements for encoding/json, same as ToJSON.
+func (heap *Heap) MarshalJSON() ([]byte, error) {
+	return heap.ToJSON()
+}
+
+// UnmarshalJSON populates the heap's elements from the input JSON representation for encoding/json, same as FromJSON.
+// The heap keeps its comparator, so it has to be instantiated with one before decoding into it,
+// e.g. a *Heap field of a struct has to be set before decoding the struct, as decoding into a heap without a comparator fails.
+func (heap *Heap) UnmarshalJSON(data []byte) error {
+	return heaps.UnmarshalJSON(data, heap.Comparator, heap.load)
+}
+
+// WriteJSON writes the JSON representation of the heap's elements to the writer, same as ToJSON.
+// The elements are written one at a time while iterating over the heap, see the jsonstream package.
+func (heap *Heap) WriteJSON(w io.Writer) error {
+	return jsonstream.WriteArray(w, heap.SeqValues())
+}
+
+// ReadJSON populates the heap's elements from the JSON representation read from the reader, pushing them one at a time in constant time each.
+func (heap *Heap) ReadJSON(r io.Reader) error {
+	return heaps.ReadJSON(r, heap.load)
+}
+
+// MarshalBinary outputs the compact binary representation of the heap's elements in pre-order,
+// see the binarycodec package.
+func (heap *Heap) MarshalBinary() ([]byte, error) {
+	return binarycodec.Marshal(heap.SeqValues())
+}
+
+// UnmarshalBinary populates the heap's elements from the input binary representation, pushing them one at a time in constant time each.
+// The heap keeps its comparator, so it has to be instantiated with one before decoding into it.
+func (heap *Heap) UnmarshalBinary(data []byte) error {
+	return heaps.UnmarshalBinary(data, heap.Comparator, heap.load)
+}
+
+// GobEncode outputs the binary representation of the heap's elements for encoding/gob, same as MarshalBinary.
+func (heap *Heap) GobEncode() ([]byte, error) {
+	return heap.MarshalBinary()
+}
+
+// GobDecode populates the heap's elements from the binary representation for encoding/gob, same as UnmarshalBinary.
+// The heap has to be instantiated with a comparator before decoding into it.
+func (heap *Heap) GobDecode(data []byte) error {
+	return heap.UnmarshalBinary(data)
+}
+
+// load replaces the heap's elements with the values.
+func (heap *Heap) load(values []any) {
+	heap.Clear()
+	heap.Push(values...)
+}
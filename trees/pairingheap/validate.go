@@ -0,0 +1,32 @@
+package pairingheap
+
+import "fmt"
+
+// Validate checks the heap order and the size and returns an error describing the first violation found,
+// or nil if no element is ordered before its parent by the heap's comparator and the heap holds as many elements as its size.
+// Validation visits every element, so it is meant for tests and debugging.
+func (heap *Heap) Validate() error {
+	if heap.root != nil && heap.root.sibling != nil {
+		return fmt.Errorf("pairingheap: root %v has a sibling %v", heap.root.value, heap.root.sibling.value)
+	}
+	count := 0
+	pending := []*node{}
+	if heap.root != nil {
+		pending = append(pending, heap.root)
+	}
+	for len(pending) > 0 {
+		parent := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		count++
+		for child := parent.child; child != nil; child = child.sibling {
+			if heap.Comparator(parent.value, child.value) > 0 {
+				return fmt.Errorf("pairingheap: element %v is ordered before its parent %v", child.value, parent.value)
+			}
+			pending = append(pending, child)
+		}
+	}
+	if count != heap.size {
+		return fmt.Errorf("pairingheap: %v elements found for size %v", count, heap.size)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package redblacktree
+
+import "github.com/Arafatk/Dataviz/containers"
+
+var _ containers.EnumerableWithKey = (*Tree)(nil)
+
+// Each calls the given function once for each element, passing that element's key and value.
+func (tree *Tree) Each(f func(key any, value any)) {
+	iterator := tree.Iterator()
+	for iterator.Next() {
+		f(iterator.Key(), iterator.Value())
+	}
+}
+
+// Map invokes the given function once for each element and returns a container
+// containing the values returned by the given function as key/value pairs.
+// The returned tree uses the same comparator as the tree.
+func (tree *Tree) Map(f func(key1 any, value1 any) (any, any)) *Tree {
+	newTree := NewWith(tree.Comparator)
+	iterator := tree.Iterator()
+	for iterator.Next() {
+		key2, value2 := f(iterator.Key(), iterator.Value())
+		newTree.Put(key2, value2)
+	}
+	return newTree
+}
+
+// Select returns a new container containing all elements for which the given function returns a true value.
+// The returned tree uses the same comparator as the tree.
+func (tree *Tree) Select(f func(key any, value any) bool) *Tree {
+	newTree := NewWith(tree.Comparator)
+	iterator := tree.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			newTree.Put(iterator.Key(), iterator.Value())
+		}
+	}
+	return newTree
+}
+
+// Any passes each element of the container to the given function and
+// returns true if the function ever returns true for any element.
+func (tree *Tree) Any(f func(key any, value any) bool) bool {
+	iterator := tree.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return true
+		}
+	}
+	return false
+}
+
+// All passes each element of the container to the given function and
+// returns true if the function returns true for all elements.
+func (tree *Tree) All(f func(key any, value any) bool) bool {
+	iterator := tree.Iterator()
+	for iterator.Next() {
+		if !f(iterator.Key(), iterator.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Find passes each element of the container to the given function and returns
+// the first (key,value) for which the function is true or nil,nil otherwise if no element
+// matches the criteria.
+func (tree *Tree) Find(f func(key any, value any) bool) (any, any) {
+	iterator := tree.Iterator()
+	for iterator.Next() {
+		if f(iterator.Key(), iterator.Value()) {
+			return iterator.Key(), iterator.Value()
+		}
+	}
+	return nil, nil
+}
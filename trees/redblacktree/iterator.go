@@ -1,14 +1,23 @@
 package redblacktree
 
-import "github.com/Arafatk/Dataviz/containers"
+import (
+	"errors"
+
+	"github.com/Arafatk/Dataviz/containers"
+)
 
 var _ containers.ReverseIteratorWithKey = (*Iterator)(nil)
 
+// ErrConcurrentModification is reported by an iterator whose tree was modified since the iteration started.
+var ErrConcurrentModification = errors.New("redblacktree: tree modified during iteration")
+
 // Iterator holding the iterator's state
 type Iterator struct {
 	tree     *Tree
 	node     *Node
 	position position
+	modCount int // modification count of the tree the iterator's position is valid for
+	err      error
 }
 
 type position byte
@@ -18,8 +27,12 @@ const (
 )
 
 // Iterator returns a stateful iterator whose elements are key/value pairs.
+//
+// The iterator is fail-fast: once the tree is modified other than through a reset of the iterator by Begin, End,
+// First or Last, Next and Prev return false and Err reports ErrConcurrentModification.
+// Iterate over a Snapshot of the tree to modify the tree meanwhile.
 func (tree *Tree) Iterator() Iterator {
-	return Iterator{tree: tree, node: nil, position: begin}
+	return Iterator{tree: tree, node: nil, position: begin, modCount: tree.modCount}
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -27,6 +40,9 @@ func (tree *Tree) Iterator() Iterator {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator) Next() bool {
+	if iterator.modified() {
+		goto end
+	}
 	if iterator.position == end {
 		goto end
 	}
@@ -69,6 +85,9 @@ between:
 // If Prev() returns true, then previous element's key and value can be retrieved by Key() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator) Prev() bool {
+	if iterator.modified() {
+		goto begin
+	}
 	if iterator.position == begin {
 		goto begin
 	}
@@ -130,6 +149,7 @@ func (iterator *Iterator) NodeColor() color {
 func (iterator *Iterator) Begin() {
 	iterator.node = nil
 	iterator.position = begin
+	iterator.reset()
 }
 
 // End moves the iterator past the last element (one-past-the-end).
@@ -137,6 +157,7 @@ func (iterator *Iterator) Begin() {
 func (iterator *Iterator) End() {
 	iterator.node = nil
 	iterator.position = end
+	iterator.reset()
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
@@ -154,3 +175,21 @@ func (iterator *Iterator) Last() bool {
 	iterator.End()
 	return iterator.Prev()
 }
+
+// Err returns ErrConcurrentModification if the iteration was stopped because the tree was modified, otherwise nil.
+func (iterator *Iterator) Err() error {
+	return iterator.err
+}
+
+// modified records and returns whether the tree was modified since the iterator was created or reset.
+func (iterator *Iterator) modified() bool {
+	if iterator.err == nil && iterator.modCount != iterator.tree.modCount {
+		iterator.err = ErrConcurrentModification
+	}
+	return iterator.err != nil
+}
+
+func (iterator *Iterator) reset() {
+	iterator.modCount = iterator.tree.modCount
+	iterator.err = nil
+}
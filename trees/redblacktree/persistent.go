@@ -0,0 +1,387 @@
+package redblacktree
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/trees/versions"
+)
+
+// PersistentTree is an immutable variant of the red-black tree.
+//
+// Put and Remove never modify a tree, instead they return a new version of it. Only the nodes on the path
+// from the root to the modified node are copied, all other nodes are shared between both versions (path copying),
+// so every modification allocates O(log n) nodes. Old versions stay valid and may be used concurrently.
+//
+// The tree is kept balanced as a left-leaning red-black tree, where red nodes are always left children.
+//
+// Reference: https://en.wikipedia.org/wiki/Persistent_data_structure
+type PersistentTree struct {
+	Root       *PersistentNode
+	Comparator utils.Comparator
+	size       int
+}
+
+// PersistentNode is a single element within the persistent tree.
+// Nodes may be shared by several versions of the tree and must not be modified.
+type PersistentNode struct {
+	Key   any
+	Value any
+	Left  *PersistentNode
+	Right *PersistentNode
+	color color
+}
+
+// NewPersistentWith instantiates an empty persistent red-black tree with the custom comparator.
+func NewPersistentWith(comparator utils.Comparator) *PersistentTree {
+	return &PersistentTree{Comparator: comparator}
+}
+
+// NewPersistentWithIntComparator instantiates an empty persistent red-black tree with the IntComparator, i.e. keys are of type int.
+func NewPersistentWithIntComparator() *PersistentTree {
+	return &PersistentTree{Comparator: utils.IntComparator}
+}
+
+// NewPersistentWithStringComparator instantiates an empty persistent red-black tree with the StringComparator, i.e. keys are of type string.
+func NewPersistentWithStringComparator() *PersistentTree {
+	return &PersistentTree{Comparator: utils.StringComparator}
+}
+
+// Put returns a new version of the tree with the key-value pair inserted.
+// If the key already exists, its value is replaced in the new version.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *PersistentTree) Put(key any, value any) *PersistentTree {
+	root, added := tree.put(tree.Root, key, value)
+	root.color = black
+	version := &PersistentTree{Root: root, Comparator: tree.Comparator, size: tree.size}
+	if added {
+		version.size++
+	}
+	return version
+}
+
+// Remove returns a new version of the tree with the key removed.
+// If the key is not found, the tree itself is returned.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *PersistentTree) Remove(key any) *PersistentTree {
+	if _, found := tree.Get(key); !found {
+		return tree
+	}
+	root := tree.Root.clone()
+	if !isRed(root.Left) && !isRed(root.Right) {
+		root.color = red
+	}
+	if root = tree.remove(root, key); root != nil {
+		root.color = black
+	}
+	return &PersistentTree{Root: root, Comparator: tree.Comparator, size: tree.size - 1}
+}
+
+// Get searches the node in the tree by key and returns its value or nil if key is not found in tree.
+// Second return parameter is true if key was found, otherwise false.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *PersistentTree) Get(key any) (value any, found bool) {
+	node := tree.Root
+	for node != nil {
+		compare := tree.Comparator(key, node.Key)
+		switch {
+		case compare == 0:
+			return node.Value, true
+		case compare < 0:
+			node = node.Left
+		case compare > 0:
+			node = node.Right
+		}
+	}
+	return nil, false
+}
+
+// Empty returns true if tree does not contain any nodes
+func (tree *PersistentTree) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of nodes in the tree.
+func (tree *PersistentTree) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in-order
+func (tree *PersistentTree) Keys() []any {
+	keys := make([]any, 0, tree.size)
+	tree.Root.walk(func(node *PersistentNode) {
+		keys = append(keys, node.Key)
+	})
+	return keys
+}
+
+// Values returns all values in-order based on the key.
+func (tree *PersistentTree) Values() []any {
+	values := make([]any, 0, tree.size)
+	tree.Root.walk(func(node *PersistentNode) {
+		values = append(values, node.Value)
+	})
+	return values
+}
+
+// Left returns the left-most (min) node or nil if tree is empty.
+func (tree *PersistentTree) Left() *PersistentNode {
+	if tree.Root == nil {
+		return nil
+	}
+	return tree.Root.min()
+}
+
+// Right returns the right-most (max) node or nil if tree is empty.
+func (tree *PersistentTree) Right() *PersistentNode {
+	node := tree.Root
+	for node != nil && node.Right != nil {
+		node = node.Right
+	}
+	return node
+}
+
+// String returns a string representation of container
+func (tree *PersistentTree) String() string {
+	str := "PersistentRedBlackTree\n"
+	if !tree.Empty() {
+		tree.Root.output("", true, &str)
+	}
+	return str
+}
+
+func (node *PersistentNode) String() string {
+	return fmt.Sprintf("%v", node.Key)
+}
+
+// Visualizer makes a visual image demonstrating the persistent red-black tree
+// using dot language and Graphviz.
+func (tree *PersistentTree) Visualizer(fileName string) bool {
+	dotString := "digraph graphname{bgcolor=white;"
+	tree.dotString(&dotString, "v", nil)
+	dotString += "}"
+	return utils.WriteDotStringToPng(fileName, dotString)
+}
+
+// VisualizeVersions makes a visual image of two versions of a persistent red-black tree side by side
+// using dot language and Graphviz. Nodes shared by both versions are drawn with a dashed gray border,
+// while nodes which exist in one version only, i.e. the nodes copied by path copying, are drawn with a thick orange border.
+func VisualizeVersions(fileName string, older *PersistentTree, newer *PersistentTree) bool {
+	return utils.WriteDotStringToPng(fileName, versionsDotString(older, newer))
+}
+
+// History records the versions of a persistent red-black tree and allows to undo and redo modifications.
+type History = versions.History[*PersistentTree]
+
+// NewHistory instantiates a history starting with the given tree as its first version.
+func NewHistory(tree *PersistentTree) *History {
+	return versions.NewHistory(tree)
+}
+
+func (tree *PersistentTree) put(node *PersistentNode, key any, value any) (*PersistentNode, bool) {
+	if node == nil {
+		return &PersistentNode{Key: key, Value: value, color: red}, true
+	}
+	node = node.clone()
+	added := false
+	switch compare := tree.Comparator(key, node.Key); {
+	case compare < 0:
+		node.Left, added = tree.put(node.Left, key, value)
+	case compare > 0:
+		node.Right, added = tree.put(node.Right, key, value)
+	default:
+		node.Key, node.Value = key, value
+	}
+	return balance(node), added
+}
+
+// remove removes the key, which must exist within the subtree, from the copied node's subtree.
+func (tree *PersistentTree) remove(node *PersistentNode, key any) *PersistentNode {
+	if tree.Comparator(key, node.Key) < 0 {
+		if !isRed(node.Left) && !isRed(node.Left.Left) {
+			node = moveRedLeft(node)
+		}
+		node.Left = tree.remove(node.Left.clone(), key)
+		return balance(node)
+	}
+	if isRed(node.Left) {
+		node = rotateRight(node)
+	}
+	if tree.Comparator(key, node.Key) == 0 && node.Right == nil {
+		return nil
+	}
+	if !isRed(node.Right) && !isRed(node.Right.Left) {
+		node = moveRedRight(node)
+	}
+	if tree.Comparator(key, node.Key) == 0 {
+		successor := node.Right.min()
+		node.Key, node.Value = successor.Key, successor.Value
+		node.Right = removeMin(node.Right.clone())
+	} else {
+		node.Right = tree.remove(node.Right.clone(), key)
+	}
+	return balance(node)
+}
+
+// removeMin removes the left-most node from the copied node's subtree.
+func removeMin(node *PersistentNode) *PersistentNode {
+	if node.Left == nil {
+		return nil
+	}
+	if !isRed(node.Left) && !isRed(node.Left.Left) {
+		node = moveRedLeft(node)
+	}
+	node.Left = removeMin(node.Left.clone())
+	return balance(node)
+}
+
+// The following helpers restructure a node that was already copied and copy any child they modify.
+
+func balance(node *PersistentNode) *PersistentNode {
+	if isRed(node.Right) && !isRed(node.Left) {
+		node = rotateLeft(node)
+	}
+	if isRed(node.Left) && isRed(node.Left.Left) {
+		node = rotateRight(node)
+	}
+	if isRed(node.Left) && isRed(node.Right) {
+		flipColors(node)
+	}
+	return node
+}
+
+func moveRedLeft(node *PersistentNode) *PersistentNode {
+	flipColors(node)
+	if isRed(node.Right.Left) {
+		node.Right = rotateRight(node.Right)
+		node = rotateLeft(node)
+		flipColors(node)
+	}
+	return node
+}
+
+func moveRedRight(node *PersistentNode) *PersistentNode {
+	flipColors(node)
+	if isRed(node.Left.Left) {
+		node = rotateRight(node)
+		flipColors(node)
+	}
+	return node
+}
+
+func rotateLeft(node *PersistentNode) *PersistentNode {
+	right := node.Right.clone()
+	node.Right = right.Left
+	right.Left = node
+	right.color, node.color = node.color, red
+	return right
+}
+
+func rotateRight(node *PersistentNode) *PersistentNode {
+	left := node.Left.clone()
+	node.Left = left.Right
+	left.Right = node
+	left.color, node.color = node.color, red
+	return left
+}
+
+func flipColors(node *PersistentNode) {
+	node.color = !node.color
+	node.Left, node.Right = node.Left.clone(), node.Right.clone()
+	node.Left.color = !node.Left.color
+	node.Right.color = !node.Right.color
+}
+
+func isRed(node *PersistentNode) bool {
+	return node != nil && node.color == red
+}
+
+func (node *PersistentNode) clone() *PersistentNode {
+	copied := *node
+	return &copied
+}
+
+func (node *PersistentNode) min() *PersistentNode {
+	for node.Left != nil {
+		node = node.Left
+	}
+	return node
+}
+
+func (node *PersistentNode) walk(visit func(node *PersistentNode)) {
+	if node == nil {
+		return
+	}
+	node.Left.walk(visit)
+	visit(node)
+	node.Right.walk(visit)
+}
+
+func (node *PersistentNode) output(prefix string, isTail bool, str *string) {
+	if node.Right != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		node.Right.output(newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += node.String() + "\n"
+	if node.Left != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		node.Left.output(newPrefix, true, str)
+	}
+}
+
+// versionsDotString returns the dot string drawing both versions in separate clusters.
+func versionsDotString(older *PersistentTree, newer *PersistentTree) string {
+	olderNodes, newerNodes := older.nodes(), newer.nodes()
+	return versions.DotString(func(dotString *string, prefix string) {
+		older.dotString(dotString, prefix, newerNodes)
+	}, func(dotString *string, prefix string) {
+		newer.dotString(dotString, prefix, olderNodes)
+	})
+}
+
+// nodes returns the set of all nodes of the tree.
+func (tree *PersistentTree) nodes() map[*PersistentNode]bool {
+	nodes := make(map[*PersistentNode]bool, tree.size)
+	tree.Root.walk(func(node *PersistentNode) {
+		nodes[node] = true
+	})
+	return nodes
+}
+
+// dotString appends the nodes and edges of the tree to the dot string, naming nodes by the given prefix
+// and their in-order index. If other is not nil, nodes are marked as shared or not shared with the other version.
+func (tree *PersistentTree) dotString(dotString *string, prefix string, other map[*PersistentNode]bool) {
+	index := make(map[*PersistentNode]string, tree.size)
+	tree.Root.walk(func(node *PersistentNode) {
+		index[node] = prefix + strconv.Itoa(len(index))
+	})
+	tree.Root.walk(func(node *PersistentNode) {
+		for _, child := range []*PersistentNode{node.Left, node.Right} {
+			if child != nil {
+				*dotString += index[node] + " -> " + index[child] + ";"
+			}
+		}
+		fill := "black"
+		if node.color == red {
+			fill = "red"
+		}
+		*dotString += index[node] + versions.NodeAttributes(node, fill, fmt.Sprintf("%v->%v", node.Key, node.Value), other)
+	})
+}
@@ -8,11 +8,13 @@
 package redblacktree
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 
 	"github.com/Arafatk/Dataviz/trees"
 	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 var _ trees.Tree = (*Tree)(nil)
@@ -28,17 +30,20 @@ type Tree struct {
 	Root       *Node
 	size       int
 	Comparator utils.Comparator
+	KeyCodec   keycodec.KeyCodec // key codec of the JSON representation, nil for a JSON object with string keys
+	modCount   int               // number of modifications, used by iterators to detect concurrent modification
+	shared     *int              // number of trees sharing the nodes since a snapshot, nil if the nodes are not shared
 }
 
 // Node is a single element within the tree
 type Node struct {
-	Key       any
-	Value     any
-	color     color
-	nodeIndex int
-	Left      *Node
-	Right     *Node
-	Parent    *Node
+	Key    any
+	Value  any
+	color  color
+	size   int // number of nodes within the subtree rooted at the node
+	Left   *Node
+	Right  *Node
+	Parent *Node
 }
 
 // NewWith instantiates a red-black tree with the custom comparator.
@@ -59,9 +64,10 @@ func NewWithStringComparator() *Tree {
 // Put inserts node into the tree.
 // Key should adhere to the comparator's type assertion, otherwise method panics.
 func (tree *Tree) Put(key any, value any) {
+	tree.modified()
 	var insertedNode *Node
 	if tree.Root == nil {
-		tree.Root = &Node{Key: key, Value: value, color: red}
+		tree.Root = &Node{Key: key, Value: value, color: red, size: 1}
 		insertedNode = tree.Root
 	} else {
 		node := tree.Root
@@ -75,7 +81,7 @@ func (tree *Tree) Put(key any, value any) {
 				return
 			case compare < 0:
 				if node.Left == nil {
-					node.Left = &Node{Key: key, Value: value, color: red}
+					node.Left = &Node{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Left
 					loop = false
 				} else {
@@ -83,7 +89,7 @@ func (tree *Tree) Put(key any, value any) {
 				}
 			case compare > 0:
 				if node.Right == nil {
-					node.Right = &Node{Key: key, Value: value, color: red}
+					node.Right = &Node{Key: key, Value: value, color: red, size: 1}
 					insertedNode = node.Right
 					loop = false
 				} else {
@@ -92,6 +98,9 @@ func (tree *Tree) Put(key any, value any) {
 			}
 		}
 		insertedNode.Parent = node
+		for ; node != nil; node = node.Parent {
+			node.size++
+		}
 	}
 	tree.insertCase1(insertedNode)
 	tree.size++
@@ -116,6 +125,9 @@ func (tree *Tree) Remove(key any) {
 	if node == nil {
 		return
 	}
+	if tree.modified() {
+		node = tree.lookup(key)
+	}
 	if node.Left != nil && node.Right != nil {
 		pred := node.Left.maximumNode()
 		node.Key = pred.Key
@@ -128,6 +140,11 @@ func (tree *Tree) Remove(key any) {
 		} else {
 			child = node.Right
 		}
+		// account for the removal before rebalancing, so that rotations keep the subtree sizes correct
+		node.size--
+		for parent := node.Parent; parent != nil; parent = parent.Parent {
+			parent.size--
+		}
 		if node.color == black {
 			node.color = nodeColor(child)
 			tree.deleteCase1(node)
@@ -254,6 +271,48 @@ func (tree *Tree) Ceiling(key any) (ceiling *Node, found bool) {
 func (tree *Tree) Clear() {
 	tree.Root = nil
 	tree.size = 0
+	tree.modCount++
+	tree.release()
+}
+
+// Snapshot returns a copy of the tree in O(1) time, meant as a frozen view for long-running iterations or visualizations.
+// The tree and the snapshot share their nodes until either of them is modified, which then first copies
+// all nodes in O(n) time, so that modifying one never affects the other or the iterators over the other.
+func (tree *Tree) Snapshot() *Tree {
+	if tree.shared == nil {
+		tree.shared = new(int)
+		*tree.shared = 1
+	}
+	*tree.shared++
+	return &Tree{Root: tree.Root, size: tree.size, Comparator: tree.Comparator, KeyCodec: tree.KeyCodec, shared: tree.shared}
+}
+
+// FromSorted replaces the contents of the tree with the given key-value pairs.
+// Keys must be sorted in strictly ascending order with respect to the tree's comparator and
+// there must be as many values as keys, otherwise an error is returned and the tree is left unchanged.
+//
+// The tree is built balanced in O(n) time instead of the O(n log n) required by repeated calls to Put.
+func (tree *Tree) FromSorted(keys []any, values []any) error {
+	if len(keys) != len(values) {
+		return errors.New("redblacktree: number of keys and values differ")
+	}
+	for i := 1; i < len(keys); i++ {
+		if tree.Comparator(keys[i-1], keys[i]) >= 0 {
+			return errors.New("redblacktree: keys are not sorted in strictly ascending order")
+		}
+	}
+	bottom := -1 // level of the deepest nodes
+	for n := len(keys); n > 0; n >>= 1 {
+		bottom++
+	}
+	tree.Root = buildSorted(keys, values, nil, 0, bottom)
+	tree.size = len(keys)
+	tree.modCount++
+	tree.release()
+	if tree.Root != nil {
+		tree.Root.color = black
+	}
+	return nil
 }
 
 // String returns a string representation of container
@@ -303,10 +362,11 @@ func output(node *Node, prefix string, isTail bool, str *string) {
 func (tree *Tree) Visualizer(fileName string) bool {
 	dotString := "digraph graphname{" // Initializing dot string
 	var colorArray []color            // Array containing colors of all nodes
+	nodeIndex := map[*Node]int{}      // Indices kept aside, as nodes may be shared with snapshots
 	it := tree.Iterator()
 	NodeIndex := 0 // An index used to mark different nodes so that node connections are easily represented in the graph
 	for i := 0; it.Next(); i++ {
-		it.node.nodeIndex = NodeIndex
+		nodeIndex[it.node] = NodeIndex
 		NodeIndex++
 		colorArray = append(colorArray, it.NodeColor())
 	}
@@ -314,15 +374,15 @@ func (tree *Tree) Visualizer(fileName string) bool {
 	it = tree.Iterator()
 	for i := 0; it.Next(); i++ { // Making all node connections
 		if it.node.Left != nil { // Left Child
-			dotString += (strconv.Itoa(it.node.nodeIndex) + " -> " + strconv.Itoa(it.node.Left.nodeIndex) + ";")
+			dotString += (strconv.Itoa(nodeIndex[it.node]) + " -> " + strconv.Itoa(nodeIndex[it.node.Left]) + ";")
 		} else {
-			dotString += (strconv.Itoa(it.node.nodeIndex) + " -> " + strconv.Itoa(NilNodes) + ";")
+			dotString += (strconv.Itoa(nodeIndex[it.node]) + " -> " + strconv.Itoa(NilNodes) + ";")
 			NilNodes++
 		}
 		if it.node.Right != nil { // Right Child
-			dotString += (strconv.Itoa(it.node.nodeIndex) + " -> " + strconv.Itoa(it.node.Right.nodeIndex) + ";")
+			dotString += (strconv.Itoa(nodeIndex[it.node]) + " -> " + strconv.Itoa(nodeIndex[it.node.Right]) + ";")
 		} else {
-			dotString += (strconv.Itoa(it.node.nodeIndex) + " -> " + strconv.Itoa(NilNodes) + ";")
+			dotString += (strconv.Itoa(nodeIndex[it.node]) + " -> " + strconv.Itoa(NilNodes) + ";")
 			NilNodes++
 		}
 	}
@@ -348,6 +408,56 @@ func (tree *Tree) Visualizer(fileName string) bool {
 	return utils.WriteDotStringToPng(fileName, dotString)
 }
 
+// modified must be called before the nodes of the tree are changed. It invalidates the running iterators and,
+// if the nodes are shared with snapshots, replaces them with copies and returns true.
+func (tree *Tree) modified() bool {
+	tree.modCount++
+	if tree.release() {
+		tree.Root = cloneNode(tree.Root, nil)
+		return true
+	}
+	return false
+}
+
+// release stops sharing the nodes of the tree and returns true if other trees still use them.
+func (tree *Tree) release() bool {
+	if tree.shared == nil {
+		return false
+	}
+	*tree.shared--
+	used := *tree.shared > 0
+	tree.shared = nil
+	return used
+}
+
+// cloneNode returns a deep copy of the subtree rooted at node.
+func cloneNode(node *Node, parent *Node) *Node {
+	if node == nil {
+		return nil
+	}
+	clone := &Node{Key: node.Key, Value: node.Value, color: node.color, size: node.size, Parent: parent}
+	clone.Left = cloneNode(node.Left, clone)
+	clone.Right = cloneNode(node.Right, clone)
+	return clone
+}
+
+// buildSorted builds a balanced subtree from sorted keys by taking the middle key as its root.
+// All levels above the bottom one are complete, so coloring only the bottom nodes red keeps the black height equal.
+func buildSorted(keys []any, values []any, parent *Node, level int, bottom int) *Node {
+	if len(keys) == 0 {
+		return nil
+	}
+	middle := len(keys) / 2
+	node := &Node{Key: keys[middle], Value: values[middle], Parent: parent, color: black}
+	if level == bottom {
+		node.color = red
+	}
+	node.Left = buildSorted(keys[:middle], values[:middle], node, level+1, bottom)
+	node.Right = buildSorted(keys[middle+1:], values[middle+1:], node, level+1, bottom)
+	node.size = len(keys)
+	return node
+}
+
 func (tree *Tree) lookup(key any) *Node {
 	node := tree.Root
 	for node != nil {
@@ -397,6 +507,8 @@ func (tree *Tree) rotateLeft(node *Node) {
 	}
 	right.Left = node
 	node.Parent = right
+	node.updateSize()
+	right.updateSize()
 }
 
 func (tree *Tree) rotateRight(node *Node) {
@@ -408,6 +520,8 @@ func (tree *Tree) rotateRight(node *Node) {
 	}
 	left.Right = node
 	node.Parent = left
+	node.updateSize()
+	left.updateSize()
 }
 
 func (tree *Tree) replaceNode(old *Node, new *Node) {
@@ -565,6 +679,17 @@ func (tree *Tree) deleteCase6(node *Node) {
 	}
 }
 
+func (node *Node) updateSize() {
+	node.size = 1 + nodeSize(node.Left) + nodeSize(node.Right)
+}
+
+func nodeSize(node *Node) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
 func nodeColor(node *Node) color {
 	if node == nil {
 		return black
@@ -0,0 +1,83 @@
+package redblacktree
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/containers/seq"
+)
+
+// Seq returns a sequence of the keys and values of the tree in-order, for use with range.
+// Panics with ErrConcurrentModification if the tree is modified during the iteration.
+func (tree *Tree) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		it := tree.Iterator()
+		for it.Next() {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the tree in-order, for use with range.
+func (tree *Tree) SeqKeys() iter.Seq[any] {
+	return seq.Keys(tree.Seq())
+}
+
+// SeqValues returns a sequence of the values of the tree in-order based on the key, for use with range.
+func (tree *Tree) SeqValues() iter.Seq[any] {
+	return seq.Values(tree.Seq())
+}
+
+// Backward returns a sequence of the keys and values of the tree in reverse order, for use with range.
+// Panics with ErrConcurrentModification if the tree is modified during the iteration.
+func (tree *Tree) Backward() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		it := tree.Iterator()
+		for it.End(); it.Prev(); {
+			if !yield(it.Key(), it.Value()) {
+				return
+			}
+		}
+		if err := it.Err(); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// Seq returns a sequence of the keys and values of the tree in-order, for use with range.
+func (tree *PersistentTree) Seq() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		tree.Root.ascend(yield)
+	}
+}
+
+// SeqKeys returns a sequence of the keys of the tree in-order, for use with range.
+func (tree *PersistentTree) SeqKeys() iter.Seq[any] {
+	return seq.Keys(tree.Seq())
+}
+
+// SeqValues returns a sequence of the values of the tree in-order based on the key, for use with range.
+func (tree *PersistentTree) SeqValues() iter.Seq[any] {
+	return seq.Values(tree.Seq())
+}
+
+// Backward returns a sequence of the keys and values of the tree in reverse order, for use with range.
+func (tree *PersistentTree) Backward() iter.Seq2[any, any] {
+	return func(yield func(key any, value any) bool) {
+		tree.Root.descend(yield)
+	}
+}
+
+// ascend yields the keys and values of the subtree in-order and returns false once yield does.
+func (node *PersistentNode) ascend(yield func(key any, value any) bool) bool {
+	return node == nil || (node.Left.ascend(yield) && yield(node.Key, node.Value) && node.Right.ascend(yield))
+}
+
+// descend yields the keys and values of the subtree in reverse order and returns false once yield does.
+func (node *PersistentNode) descend(yield func(key any, value any) bool) bool {
+	return node == nil || (node.Right.descend(yield) && yield(node.Key, node.Value) && node.Left.descend(yield))
+}
@@ -1,34 +1,131 @@
 package redblacktree
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
+	"errors"
+	"io"
 
-	"github.com/Arafatk/Dataviz/containers"
-	"github.com/Arafatk/Dataviz/utils"
+	"github.com/riadafridishibly/DataViz/containers"
+	"github.com/riadafridishibly/DataViz/containers/binarycodec"
+	"github.com/riadafridishibly/DataViz/containers/jsonstream"
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
 )
 
 var _ containers.JSONSerializer = (*Tree)(nil)
 var _ containers.JSONDeserializer = (*Tree)(nil)
+var _ containers.BinarySerializer = (*Tree)(nil)
+var _ containers.BinaryDeserializer = (*Tree)(nil)
+var _ gob.GobEncoder = (*Tree)(nil)
+var _ gob.GobDecoder = (*Tree)(nil)
+var _ json.Marshaler = (*Tree)(nil)
+var _ json.Unmarshaler = (*Tree)(nil)
 
-// ToJSON outputs the JSON representation of list's elements.
+// ToJSON outputs the JSON representation of the tree's elements in order.
+// If the tree has a KeyCodec, the elements are output as an array of key-value pairs with the keys encoded by the codec,
+// otherwise as a JSON object with the keys converted to strings.
 func (tree *Tree) ToJSON() ([]byte, error) {
-	elements := make(map[string]any)
-	it := tree.Iterator()
-	for it.Next() {
-		elements[utils.ToString(it.Key())] = it.Value()
+	var buffer bytes.Buffer
+	if err := tree.WriteJSON(&buffer); err != nil {
+		return nil, err
 	}
-	return json.Marshal(&elements)
+	return buffer.Bytes(), nil
 }
 
-// FromJSON populates list's elements from the input JSON representation.
+// FromJSON populates the tree's elements from the input JSON representation.
+// The keys of an array of key-value pairs are decoded by the tree's KeyCodec, or by keycodec.Typed if the tree has none,
+// while the keys of a JSON object are restored as strings, so an error is returned if the comparator cannot compare strings.
+// If the keys appear in ascending order within the input, the tree is built directly from them in linear time.
 func (tree *Tree) FromJSON(data []byte) error {
-	elements := make(map[string]any)
-	err := json.Unmarshal(data, &elements)
-	if err == nil {
-		tree.Clear()
-		for key, value := range elements {
-			tree.Put(key, value)
-		}
-	}
-	return err
+	return tree.ReadJSON(bytes.NewReader(data))
+}
+
+// MarshalJSON outputs the JSON representation of the tree's elements for encoding/json, same as ToJSON.
+func (tree *Tree) MarshalJSON() ([]byte, error) {
+	return tree.ToJSON()
+}
+
+// UnmarshalJSON populates the tree's elements from the input JSON representation for encoding/json, same as FromJSON.
+// The tree keeps its comparator, so it has to be instantiated with one before decoding into it,
+// e.g. a *Tree field of a struct has to be set before decoding the struct, as decoding into a tree without a comparator fails.
+func (tree *Tree) UnmarshalJSON(data []byte) error {
+	if tree.Comparator == nil {
+		return errors.New("redblacktree: tree has no comparator")
+	}
+	return tree.FromJSON(data)
+}
+
+// WriteJSON writes the JSON representation of the tree's elements to the writer, same as ToJSON.
+// The elements are written one at a time while iterating over the tree, see the jsonstream package.
+func (tree *Tree) WriteJSON(w io.Writer) error {
+	return jsonstream.WriteElements(w, tree.KeyCodec, tree.Seq())
+}
+
+// ReadJSON populates the tree's elements from the JSON representation read from the reader, same as FromJSON.
+// The elements are decoded one at a time while reading, see the jsonstream package.
+func (tree *Tree) ReadJSON(r io.Reader) error {
+	keys, values, err := jsonstream.ReadElements(r, tree.keyCodec())
+	if err != nil {
+		return err
+	}
+	if err := keycodec.CheckKeys(keys, tree.Comparator); err != nil {
+		return err
+	}
+	tree.load(keys, values)
+	return nil
+}
+
+// MarshalBinary outputs the compact binary representation of the tree's elements in order, see the binarycodec package.
+func (tree *Tree) MarshalBinary() ([]byte, error) {
+	return binarycodec.MarshalPairs(tree.Seq())
+}
+
+// UnmarshalBinary populates the tree's elements from the input binary representation.
+// Returns an error if the comparator cannot compare the decoded keys.
+// The tree keeps its comparator, so it has to be instantiated with one before decoding into it.
+func (tree *Tree) UnmarshalBinary(data []byte) error {
+	if tree.Comparator == nil {
+		return errors.New("redblacktree: tree has no comparator")
+	}
+	keys, values, err := binarycodec.UnmarshalPairs(data)
+	if err != nil {
+		return err
+	}
+	if err := keycodec.CheckKeys(keys, tree.Comparator); err != nil {
+		return err
+	}
+	tree.load(keys, values)
+	return nil
+}
+
+// GobEncode outputs the binary representation of the tree's elements for encoding/gob, same as MarshalBinary.
+func (tree *Tree) GobEncode() ([]byte, error) {
+	return tree.MarshalBinary()
+}
+
+// GobDecode populates the tree's elements from the binary representation for encoding/gob, same as UnmarshalBinary.
+// The tree has to be instantiated with a comparator before decoding into it.
+func (tree *Tree) GobDecode(data []byte) error {
+	return tree.UnmarshalBinary(data)
+}
+
+// load replaces the tree's elements with the keys and values.
+// If the keys are in ascending order, the tree is built directly from them in linear time.
+func (tree *Tree) load(keys []any, values []any) {
+	if tree.FromSorted(keys, values) == nil {
+		return
+	}
+	tree.Clear()
+	for i, key := range keys {
+		tree.Put(key, values[i])
+	}
+}
+
+// keyCodec returns the tree's KeyCodec, or keycodec.Typed if the tree has none.
+func (tree *Tree) keyCodec() keycodec.KeyCodec {
+	if tree.KeyCodec == nil {
+		return keycodec.Typed
+	}
+	return tree.KeyCodec
 }
@@ -0,0 +1,118 @@
+package redblacktree
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/riadafridishibly/DataViz/containers/keycodec"
+)
+
+// shapeNode is the JSON representation of a node within the tree's shape.
+type shapeNode struct {
+	Key   json.RawMessage `json:"key"`
+	Value any             `json:"value"`
+	Color string          `json:"color"`
+	Left  *shapeNode      `json:"left,omitempty"`
+	Right *shapeNode      `json:"right,omitempty"`
+}
+
+// ToShapeJSON outputs the JSON representation of the tree's exact shape, i.e. every node with its color and children:
+//
+//	{"root":{"key":{"type":"int","value":2},"value":"b","color":"black","left":{...},"right":{...}}}
+//
+// Keys are encoded by the tree's KeyCodec, or by keycodec.Typed if the tree has none.
+// Unlike ToJSON, whose output may be loaded into a tree of a different shape, FromShapeJSON restores the same tree node by node,
+// e.g. to save a scenario shown by the Visualizer and reproduce it later.
+func (tree *Tree) ToShapeJSON() ([]byte, error) {
+	codec := tree.keyCodec()
+	var encode func(node *Node) (*shapeNode, error)
+	encode = func(node *Node) (*shapeNode, error) {
+		if node == nil {
+			return nil, nil
+		}
+		key, err := codec.EncodeKey(node.Key)
+		if err != nil {
+			return nil, err
+		}
+		shape := &shapeNode{Key: key, Value: node.Value, Color: "black"}
+		if node.color == red {
+			shape.Color = "red"
+		}
+		if shape.Left, err = encode(node.Left); err != nil {
+			return nil, err
+		}
+		if shape.Right, err = encode(node.Right); err != nil {
+			return nil, err
+		}
+		return shape, nil
+	}
+	root, err := encode(tree.Root)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Root *shapeNode `json:"root"`
+	}{root})
+}
+
+// FromShapeJSON replaces the tree's elements with the nodes of the input JSON representation output by ToShapeJSON,
+// keeping their colors and children as they are.
+// Returns an error and leaves the tree unchanged if the input does not represent a valid red-black tree (see Validate).
+// Returns an error as well if the comparator cannot compare the decoded keys.
+func (tree *Tree) FromShapeJSON(data []byte) error {
+	var shape struct {
+		Root *shapeNode `json:"root"`
+	}
+	if err := json.Unmarshal(data, &shape); err != nil {
+		return err
+	}
+	codec := tree.keyCodec()
+	var keys []any
+	var decode func(shape *shapeNode, parent *Node) (*Node, error)
+	decode = func(shape *shapeNode, parent *Node) (*Node, error) {
+		if shape == nil {
+			return nil, nil
+		}
+		if shape.Key == nil {
+			return nil, errors.New("redblacktree: node has no key")
+		}
+		node := &Node{Value: shape.Value, Parent: parent}
+		switch shape.Color {
+		case "red":
+			node.color = red
+		case "black":
+			node.color = black
+		default:
+			return nil, fmt.Errorf("redblacktree: node has invalid color %q", shape.Color)
+		}
+		var err error
+		if node.Key, err = codec.DecodeKey(shape.Key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, node.Key)
+		if node.Left, err = decode(shape.Left, node); err != nil {
+			return nil, err
+		}
+		if node.Right, err = decode(shape.Right, node); err != nil {
+			return nil, err
+		}
+		node.size = 1 + nodeSize(node.Left) + nodeSize(node.Right)
+		return node, nil
+	}
+	root, err := decode(shape.Root, nil)
+	if err != nil {
+		return err
+	}
+	if err := keycodec.CheckKeys(keys, tree.Comparator); err != nil {
+		return err
+	}
+	loaded := &Tree{Root: root, size: nodeSize(root), Comparator: tree.Comparator}
+	if err := loaded.Validate(); err != nil {
+		return err
+	}
+	tree.Root, tree.size = loaded.Root, loaded.size
+	tree.modCount++
+	tree.release()
+	return nil
+}
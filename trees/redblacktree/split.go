@@ -0,0 +1,180 @@
+package redblacktree
+
+import "errors"
+
+// Split moves all nodes of the tree into two new trees, the left one holding the keys smaller than the given key
+// and the right one holding the keys larger than or equal to the given key. The tree is left empty.
+//
+// Split takes O(log n) time, nodes are relinked instead of copied.
+// Key should adhere to the comparator's type assertion, otherwise method panics.
+func (tree *Tree) Split(key any) (left *Tree, right *Tree) {
+	tree.modified()
+	leftRoot, _, rightRoot, _ := tree.split(tree.Root, blackHeight(tree.Root), key)
+	left, right = NewWith(tree.Comparator), NewWith(tree.Comparator)
+	left.KeyCodec, right.KeyCodec = tree.KeyCodec, tree.KeyCodec
+	left.Root, left.size = leftRoot, nodeSize(leftRoot)
+	right.Root, right.size = rightRoot, nodeSize(rightRoot)
+	tree.Clear()
+	return left, right
+}
+
+// Join moves all nodes of the other tree into the tree.
+// All keys of the tree must be smaller than all keys of the other tree, otherwise an error is returned and both trees are left unchanged.
+// The other tree is left empty. If the tree has no KeyCodec, it takes over the other tree's KeyCodec.
+//
+// Join takes O(log n) time, nodes are relinked instead of copied.
+func (tree *Tree) Join(other *Tree) error {
+	if other.Empty() {
+		return nil
+	}
+	if !tree.Empty() && tree.Comparator(tree.Right().Key, other.Left().Key) >= 0 {
+		return errors.New("redblacktree: keys of the joined trees overlap")
+	}
+	tree.modified()
+	if tree.KeyCodec == nil {
+		tree.KeyCodec = other.KeyCodec
+	}
+	size := tree.size + other.size
+	first := other.Left()
+	middle := &Node{Key: first.Key, Value: first.Value}
+	other.Remove(first.Key)
+	tree.Root, _ = join(tree.Root, blackHeight(tree.Root), middle, other.Root, blackHeight(other.Root))
+	tree.size = size
+	other.Clear()
+	return nil
+}
+
+// split splits the subtree rooted at node of the given black height into the subtrees of keys smaller and
+// larger than or equal to the key, returning them together with their black heights.
+func (tree *Tree) split(node *Node, height int, key any) (left *Node, leftHeight int, right *Node, rightHeight int) {
+	if node == nil {
+		return nil, 0, nil, 0
+	}
+	nodeLeft, nodeRight := detach(node.Left), detach(node.Right)
+	node.Left, node.Right = nil, nil
+	if node.color == black {
+		height--
+	}
+	if tree.Comparator(key, node.Key) <= 0 {
+		left, leftHeight, right, rightHeight = tree.split(nodeLeft, height, key)
+		right, rightHeight = join(right, rightHeight, node, nodeRight, height)
+		return left, leftHeight, right, rightHeight
+	}
+	left, leftHeight, right, rightHeight = tree.split(nodeRight, height, key)
+	left, leftHeight = join(nodeLeft, height, node, left, leftHeight)
+	return left, leftHeight, right, rightHeight
+}
+
+// join links the valid red-black subtrees left and right of the given black heights through the middle node,
+// whose key lies between the keys of both subtrees, and returns the root and black height of the resulting valid red-black tree.
+// The middle node is hung into the taller subtree at the level of the shorter one and the tree is then
+// fixed like after an insertion, so the work is proportional to the difference of both black heights.
+func join(left *Node, leftHeight int, middle *Node, right *Node, rightHeight int) (*Node, int) {
+	if nodeColor(left) == red {
+		left.color = black
+		leftHeight++
+	}
+	if nodeColor(right) == red {
+		right.color = black
+		rightHeight++
+	}
+	middle.Parent, middle.color = nil, red
+
+	if leftHeight == rightHeight {
+		link(middle, left, right)
+		middle.color = black
+		return middle, leftHeight + 1
+	}
+
+	tree := &Tree{}
+	var parent *Node
+	height := leftHeight
+	if leftHeight > rightHeight {
+		// descend along the right spine of the left subtree to a black node of the right subtree's black height
+		tree.Root = left
+		node, nodeHeight := left, leftHeight
+		for !(nodeHeight == rightHeight && nodeColor(node) == black) {
+			if node.color == black {
+				nodeHeight--
+			}
+			node.size += 1 + nodeSize(right)
+			parent, node = node, node.Right
+		}
+		link(middle, node, right)
+		parent.Right = middle
+	} else {
+		// descend along the left spine of the right subtree to a black node of the left subtree's black height
+		tree.Root = right
+		height = rightHeight
+		node, nodeHeight := right, rightHeight
+		for !(nodeHeight == leftHeight && nodeColor(node) == black) {
+			if node.color == black {
+				nodeHeight--
+			}
+			node.size += 1 + nodeSize(left)
+			parent, node = node, node.Left
+		}
+		link(middle, left, node)
+		parent.Left = middle
+	}
+	middle.Parent = parent
+	if tree.joinCase1(middle) {
+		height++
+	}
+	return tree.Root, height
+}
+
+// joinCase1 restores the red-black properties above the red node like insertCase1 does after an insertion,
+// and reports whether the black height of the tree grew because its root had to be recolored.
+func (tree *Tree) joinCase1(node *Node) bool {
+	for {
+		if node.Parent == nil {
+			grew := node.color == red
+			node.color = black
+			return grew
+		}
+		if nodeColor(node.Parent) == black {
+			return false
+		}
+		uncle := node.uncle()
+		if nodeColor(uncle) != red {
+			tree.insertCase4(node)
+			return false
+		}
+		node.Parent.color = black
+		uncle.color = black
+		node = node.grandparent()
+		node.color = red
+	}
+}
+
+// link makes left and right the children of node.
+func link(node *Node, left *Node, right *Node) {
+	node.Left, node.Right = left, right
+	if left != nil {
+		left.Parent = node
+	}
+	if right != nil {
+		right.Parent = node
+	}
+	node.updateSize()
+}
+
+// detach cuts the node off its parent and returns it.
+func detach(node *Node) *Node {
+	if node != nil {
+		node.Parent = nil
+	}
+	return node
+}
+
+// blackHeight returns the number of black nodes on any path from node down to a leaf.
+func blackHeight(node *Node) int {
+	height := 0
+	for ; node != nil; node = node.Left {
+		if node.color == black {
+			height++
+		}
+	}
+	return height
+}
@@ -0,0 +1,123 @@
+package redblacktree
+
+import "fmt"
+
+// Validate checks the structural invariants of the tree and returns an error describing the first violation found,
+// or nil if the tree is a valid red-black tree.
+//
+// Checked are the red-black properties (black root, no red node with a red child, equal number of black nodes
+// on all paths from a node to its leaves), the parent pointers, the order of the keys, the subtree sizes and the tree's size.
+// Validation visits every node, so it is meant for tests and debugging.
+func (tree *Tree) Validate() error {
+	if tree.Root == nil {
+		if tree.size != 0 {
+			return fmt.Errorf("redblacktree: empty tree has size %v", tree.size)
+		}
+		return nil
+	}
+	if tree.Root.Parent != nil {
+		return fmt.Errorf("redblacktree: root %v has parent %v", tree.Root, tree.Root.Parent)
+	}
+	if tree.Root.color != black {
+		return fmt.Errorf("redblacktree: root %v is red", tree.Root)
+	}
+	var previous *Node
+	var validate func(node *Node) (int, error)
+	validate = func(node *Node) (int, error) {
+		if node == nil {
+			return 1, nil
+		}
+		for _, child := range []*Node{node.Left, node.Right} {
+			if child == nil {
+				continue
+			}
+			if child.Parent != node {
+				return 0, fmt.Errorf("redblacktree: node %v has parent %v instead of %v", child, child.Parent, node)
+			}
+			if node.color == red && child.color == red {
+				return 0, fmt.Errorf("redblacktree: red node %v has red child %v", node, child)
+			}
+		}
+		left, err := validate(node.Left)
+		if err != nil {
+			return 0, err
+		}
+		if previous != nil && tree.Comparator(previous.Key, node.Key) >= 0 {
+			return 0, fmt.Errorf("redblacktree: key %v is not smaller than its successor %v", previous.Key, node.Key)
+		}
+		previous = node
+		right, err := validate(node.Right)
+		if err != nil {
+			return 0, err
+		}
+		if left != right {
+			return 0, fmt.Errorf("redblacktree: subtrees of node %v have black heights %v and %v", node, left, right)
+		}
+		if size := 1 + nodeSize(node.Left) + nodeSize(node.Right); node.size != size {
+			return 0, fmt.Errorf("redblacktree: node %v has subtree size %v instead of %v", node, node.size, size)
+		}
+		if node.color == black {
+			left++
+		}
+		return left, nil
+	}
+	if _, err := validate(tree.Root); err != nil {
+		return err
+	}
+	if tree.Root.size != tree.size {
+		return fmt.Errorf("redblacktree: tree has size %v but holds %v nodes", tree.size, tree.Root.size)
+	}
+	return nil
+}
+
+// Validate checks the structural invariants of the persistent tree and returns an error describing the first violation found,
+// or nil if the tree is a valid left-leaning red-black tree.
+//
+// Checked are the red-black properties, that red nodes are left children only, the order of the keys and the tree's size.
+// Validation visits every node, so it is meant for tests and debugging.
+func (tree *PersistentTree) Validate() error {
+	if isRed(tree.Root) {
+		return fmt.Errorf("redblacktree: root %v is red", tree.Root)
+	}
+	var previous *PersistentNode
+	size := 0
+	var validate func(node *PersistentNode) (int, error)
+	validate = func(node *PersistentNode) (int, error) {
+		if node == nil {
+			return 1, nil
+		}
+		if isRed(node.Right) {
+			return 0, fmt.Errorf("redblacktree: node %v has red right child %v", node, node.Right)
+		}
+		if isRed(node) && isRed(node.Left) {
+			return 0, fmt.Errorf("redblacktree: red node %v has red child %v", node, node.Left)
+		}
+		left, err := validate(node.Left)
+		if err != nil {
+			return 0, err
+		}
+		if previous != nil && tree.Comparator(previous.Key, node.Key) >= 0 {
+			return 0, fmt.Errorf("redblacktree: key %v is not smaller than its successor %v", previous.Key, node.Key)
+		}
+		previous = node
+		size++
+		right, err := validate(node.Right)
+		if err != nil {
+			return 0, err
+		}
+		if left != right {
+			return 0, fmt.Errorf("redblacktree: subtrees of node %v have black heights %v and %v", node, left, right)
+		}
+		if node.color == black {
+			left++
+		}
+		return left, nil
+	}
+	if _, err := validate(tree.Root); err != nil {
+		return err
+	}
+	if size != tree.size {
+		return fmt.Errorf("redblacktree: tree has size %v but holds %v nodes", tree.size, size)
+	}
+	return nil
+}
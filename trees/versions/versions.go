@@ -0,0 +1,110 @@
+// Package versions provides the version history and the version drawings shared by the persistent trees.
+//
+// A persistent tree is never modified in place: every modification returns a new version of the tree,
+// which shares all nodes but the ones on the modified path with the previous version.
+//
+// Reference: https://en.wikipedia.org/wiki/Persistent_data_structure
+package versions
+
+// Tree interface that all persistent trees implement
+type Tree[T any] interface {
+	comparable
+	Put(key any, value any) T
+	Remove(key any) T
+}
+
+// History records the versions of a persistent tree and allows to undo and redo modifications.
+type History[T Tree[T]] struct {
+	versions []T
+	current  int
+}
+
+// NewHistory instantiates a history starting with the given tree as its first version.
+func NewHistory[T Tree[T]](tree T) *History[T] {
+	return &History[T]{versions: []T{tree}}
+}
+
+// Put inserts the key-value pair into the current version and records the result as the new current version.
+// Versions undone before are discarded.
+func (history *History[T]) Put(key any, value any) T {
+	return history.record(history.Current().Put(key, value))
+}
+
+// Remove removes the key from the current version and records the result as the new current version.
+// Versions undone before are discarded. Nothing is recorded if the key is not found.
+func (history *History[T]) Remove(key any) T {
+	current := history.Current()
+	if tree := current.Remove(key); tree != current {
+		return history.record(tree)
+	}
+	return current
+}
+
+// Current returns the current version of the tree.
+func (history *History[T]) Current() T {
+	return history.versions[history.current]
+}
+
+// Version returns the version with the given index, the first version having index 0.
+// Second return parameter is false if there is no such version.
+func (history *History[T]) Version(index int) (tree T, found bool) {
+	if index < 0 || index >= len(history.versions) {
+		return tree, false
+	}
+	return history.versions[index], true
+}
+
+// Versions returns the number of recorded versions, including those that were undone.
+func (history *History[T]) Versions() int {
+	return len(history.versions)
+}
+
+// Undo steps back to the previous version and returns true if there was a previous version.
+func (history *History[T]) Undo() bool {
+	if history.current == 0 {
+		return false
+	}
+	history.current--
+	return true
+}
+
+// Redo steps forward to the version undone last and returns true if there was such a version.
+func (history *History[T]) Redo() bool {
+	if history.current == len(history.versions)-1 {
+		return false
+	}
+	history.current++
+	return true
+}
+
+func (history *History[T]) record(tree T) T {
+	history.versions = append(history.versions[:history.current+1], tree)
+	history.current++
+	return tree
+}
+
+// DotString returns the dot string drawing two versions side by side in separate clusters.
+// Each draw function appends the nodes and edges of its version, named by the given prefix.
+func DotString(drawOlder func(dotString *string, prefix string), drawNewer func(dotString *string, prefix string)) string {
+	dotString := "digraph graphname{bgcolor=white;"
+	dotString += "subgraph cluster_older{label=\"older\";"
+	drawOlder(&dotString, "a")
+	dotString += "}subgraph cluster_newer{label=\"newer\";"
+	drawNewer(&dotString, "b")
+	dotString += "}}"
+	return dotString
+}
+
+// NodeAttributes returns the dot attributes of a node filled with the given color and labeled with the given label.
+// The node is drawn plain if other is nil, otherwise it is marked as shared or not shared with the other version:
+// shared nodes are drawn with a dashed gray border and nodes of one version only,
+// i.e. the nodes copied by path copying, with a thick orange border.
+func NodeAttributes[N comparable](node N, fill string, label string, other map[N]bool) string {
+	border := fill + ", style=filled"
+	if other != nil && other[node] {
+		border = "gray, style=\"filled,dashed\", penwidth=2"
+	} else if other != nil {
+		border = "orange1, style=filled, penwidth=4"
+	}
+	return "[color=" + border + ", fillcolor = " + fill + ", fontcolor=white,label=\"" + label + "\"];"
+}
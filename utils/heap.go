@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"iter"
+
+	"github.com/riadafridishibly/DataViz/trees/binaryheap"
+)
+
+// TopK returns the k largest values of the sequence with respect to the given comparator, largest first.
+//
+// The values are streamed through a binary heap holding the k largest values seen so far,
+// which takes O(n log k) time and O(k) memory, rather than sorting all of them.
+func TopK[T any](values iter.Seq[T], k int, comparator Comparator) []T {
+	return smallestK(values, k, func(a, b any) int { return -comparator(a, b) })
+}
+
+// BottomK returns the k smallest values of the sequence with respect to the given comparator, smallest first.
+//
+// The values are streamed through a binary heap holding the k smallest values seen so far,
+// which takes O(n log k) time and O(k) memory, rather than sorting all of them.
+func BottomK[T any](values iter.Seq[T], k int, comparator Comparator) []T {
+	return smallestK(values, k, comparator)
+}
+
+// smallestK returns the k values ordered first by the comparator, in order.
+// The heap is ordered the other way around, so its top is the value to evict when a value ordered before it comes.
+func smallestK[T any](values iter.Seq[T], k int, comparator Comparator) []T {
+	if k <= 0 {
+		return []T{}
+	}
+	heap := binaryheap.NewWith(func(a, b any) int { return comparator(b, a) })
+	for value := range values {
+		if heap.Size() < k {
+			heap.Push(value)
+		} else if top, _ := heap.Peek(); comparator(value, top) < 0 {
+			heap.Pop()
+			heap.Push(value)
+		}
+	}
+	result := make([]T, heap.Size())
+	for i := len(result) - 1; i >= 0; i-- {
+		value, _ := heap.Pop()
+		result[i] = value.(T)
+	}
+	return result
+}
+
+// Median keeps track of the median of a stream of values in two binary heaps:
+// a heap of the lower half of the values with the largest on top and a heap of the upper half with the smallest on top.
+//
+// Adding a value takes O(log n) time, while the median is read in constant time.
+type Median[T any] struct {
+	lower      *binaryheap.Heap
+	upper      *binaryheap.Heap
+	comparator Comparator
+}
+
+// NewMedian instantiates a new streaming median of the values ordered by the given comparator.
+func NewMedian[T any](comparator Comparator) *Median[T] {
+	return &Median[T]{
+		lower:      binaryheap.NewWith(func(a, b any) int { return comparator(b, a) }),
+		upper:      binaryheap.NewWith(func(a, b any) int { return comparator(a, b) }),
+		comparator: comparator,
+	}
+}
+
+// Add adds the value to the stream.
+func (median *Median[T]) Add(value T) {
+	if top, ok := median.lower.Peek(); ok && median.comparator(value, top) > 0 {
+		median.upper.Push(value)
+	} else {
+		median.lower.Push(value)
+	}
+	// rebalance, so the lower half holds as many values as the upper half or one more
+	if median.lower.Size() > median.upper.Size()+1 {
+		value, _ := median.lower.Pop()
+		median.upper.Push(value)
+	} else if median.upper.Size() > median.lower.Size() {
+		value, _ := median.upper.Pop()
+		median.lower.Push(value)
+	}
+}
+
+// Median returns the middle value of the values added so far in their order.
+// For an even number of values, the two middle values are returned as low and high, otherwise both are the middle value.
+// Third return parameter is true, unless no value was added.
+func (median *Median[T]) Median() (low T, high T, ok bool) {
+	top, ok := median.lower.Peek()
+	if !ok {
+		return low, high, false
+	}
+	low, high = top.(T), top.(T)
+	if median.lower.Size() == median.upper.Size() {
+		top, _ = median.upper.Peek()
+		high = top.(T)
+	}
+	return low, high, true
+}
+
+// Size returns the number of values added to the stream.
+func (median *Median[T]) Size() int {
+	return median.lower.Size() + median.upper.Size()
+}
+
+// HeapSort sorts values (in-place) with respect to the given comparator.
+//
+// The values are arranged into a binary max heap within the slice, then the top is repeatedly swapped behind the shrinking heap.
+// It takes O(n log n) time even in the worst case and no extra memory, but it is usually slower than Sort.
+func HeapSort[T any](values []T, comparator Comparator) {
+	compare, swap := maxHeap(values, comparator)
+	for index := len(values)/2 - 1; index >= 0; index-- {
+		binaryheap.SiftDown(index, len(values), compare, swap)
+	}
+	for end := len(values) - 1; end > 0; end-- {
+		swap(0, end)
+		binaryheap.SiftDown(0, end, compare, swap)
+	}
+}
+
+// maxHeap returns the compare and swap functions of a binary max heap within the values for the sift functions of binaryheap.
+func maxHeap[T any](values []T, comparator Comparator) (compare func(i, j int) int, swap func(i, j int)) {
+	compare = func(i, j int) int { return comparator(values[j], values[i]) }
+	swap = func(i, j int) { values[i], values[j] = values[j], values[i] }
+	return compare, swap
+}
@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestTopK(t *testing.T) {
+	values := []int{5, 1, 9, 3, 7, 9, 2}
+
+	if actualValue, expectedValue := fmt.Sprint(TopK(slices.Values(values), 3, IntComparator)), "[9 9 7]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(BottomK(slices.Values(values), 3, IntComparator)), "[1 2 3]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := fmt.Sprint(TopK(slices.Values(values), 10, IntComparator)), "[9 9 7 5 3 2 1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue := TopK(slices.Values(values), 0, IntComparator); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue := BottomK(slices.Values([]int{}), 2, IntComparator); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+	if actualValue, expectedValue := fmt.Sprint(TopK(slices.Values([]string{"b", "c", "a"}), 2, StringComparator)), "[c b]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestTopKRandom(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		values := make([]int, rand.Intn(100))
+		for j := range values {
+			values[j] = rand.Intn(50)
+		}
+		k := rand.Intn(20)
+		sorted := slices.Clone(values)
+		sort.Ints(sorted)
+
+		bottom := sorted[:min(k, len(sorted))]
+		if actualValue, expectedValue := fmt.Sprint(BottomK(slices.Values(values), k, IntComparator)), fmt.Sprint(bottom); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+		top := slices.Clone(sorted[len(sorted)-min(k, len(sorted)):])
+		slices.Reverse(top)
+		if actualValue, expectedValue := fmt.Sprint(TopK(slices.Values(values), k, IntComparator)), fmt.Sprint(top); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestMedian(t *testing.T) {
+	median := NewMedian[int](IntComparator)
+	if _, _, ok := median.Median(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+
+	tests := []struct {
+		value     int
+		low, high int
+	}{
+		{5, 5, 5},
+		{1, 1, 5},
+		{9, 5, 5},
+		{3, 3, 5},
+		{7, 5, 5},
+		{2, 3, 5},
+	}
+	for _, test := range tests {
+		median.Add(test.value)
+		if low, high, ok := median.Median(); low != test.low || high != test.high || !ok {
+			t.Errorf("Got %v %v %v expected %v %v %v", low, high, ok, test.low, test.high, true)
+		}
+	}
+	if actualValue, expectedValue := median.Size(), len(tests); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMedianRandom(t *testing.T) {
+	median := NewMedian[int](IntComparator)
+	values := []int{}
+	for i := 0; i < 500; i++ {
+		value := rand.Intn(100)
+		median.Add(value)
+		values = append(values, value)
+		sort.Ints(values)
+		expectedLow, expectedHigh := values[(len(values)-1)/2], values[len(values)/2]
+		if low, high, _ := median.Median(); low != expectedLow || high != expectedHigh {
+			t.Fatalf("Got %v %v expected %v %v", low, high, expectedLow, expectedHigh)
+		}
+	}
+}
+
+func TestHeapSort(t *testing.T) {
+	strings := []string{"d", "a", "b", "c", "a"}
+	HeapSort(strings, StringComparator)
+	if actualValue, expectedValue := fmt.Sprint(strings), "[a a b c d]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	HeapSort([]int{}, IntComparator)
+	single := []int{1}
+	HeapSort(single, IntComparator)
+	if actualValue, expectedValue := fmt.Sprint(single), "[1]"; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	ints := []any{}
+	for i := 0; i < 10000; i++ {
+		ints = append(ints, rand.Intn(1000))
+	}
+	HeapSort(ints, IntComparator)
+	for i := 1; i < len(ints); i++ {
+		if ints[i-1].(int) > ints[i].(int) {
+			t.Errorf("Not sorted!")
+		}
+	}
+}
+
+func BenchmarkHeapSortRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []any{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		values := slices.Clone(ints)
+		b.StartTimer()
+		HeapSort(values, IntComparator)
+	}
+}
+
+func BenchmarkSortRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []any{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		values := slices.Clone(ints)
+		b.StartTimer()
+		Sort(values, IntComparator)
+	}
+}
+
+func BenchmarkTopK10Of100000(b *testing.B) {
+	b.StopTimer()
+	ints := []any{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		TopK(slices.Values(ints), 10, IntComparator)
+	}
+}
+
+func BenchmarkSortTop10Of100000(b *testing.B) {
+	b.StopTimer()
+	ints := []any{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		values := slices.Clone(ints)
+		b.StartTimer()
+		Sort(values, func(a, b any) int { return -IntComparator(a, b) })
+		_ = values[:10]
+	}
+}
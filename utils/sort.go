@@ -1,6 +1,10 @@
 package utils
 
-import "sort"
+import (
+	"sort"
+
+	"github.com/riadafridishibly/DataViz/trees/binaryheap"
+)
 
 // Sort sorts values (in-place) with respect to the given comparator.
 //
@@ -23,3 +27,60 @@ func (s sortable[T]) Swap(i, j int) {
 func (s sortable[T]) Less(i, j int) bool {
 	return s.comparator(s.values[i], s.values[j]) < 0
 }
+
+// SortStable sorts values (in-place) with respect to the given comparator, keeping equal values in their original order.
+//
+// Uses Go's stable sort (insertion sort for small blocks, merged in place with symmerge).
+func SortStable[T any](values []T, comparator Comparator) {
+	sort.Stable(sortable[T]{values, comparator})
+}
+
+// IsSorted returns true if values are sorted with respect to the given comparator.
+func IsSorted[T any](values []T, comparator Comparator) bool {
+	for i := 1; i < len(values); i++ {
+		if comparator(values[i-1], values[i]) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SearchFirst searches the values sorted with respect to the given comparator for the first one equal to the target using binary search.
+// Returns its index and true if found, otherwise the index at which the target would be inserted to keep the values sorted and false.
+func SearchFirst[T any](values []T, target T, comparator Comparator) (index int, found bool) {
+	index = sort.Search(len(values), func(i int) bool { return comparator(values[i], target) >= 0 })
+	return index, index < len(values) && comparator(values[index], target) == 0
+}
+
+// SearchLast searches the values sorted with respect to the given comparator for the last one equal to the target using binary search.
+// Returns its index and true if found, otherwise the index at which the target would be inserted to keep the values sorted and false.
+func SearchLast[T any](values []T, target T, comparator Comparator) (index int, found bool) {
+	index = sort.Search(len(values), func(i int) bool { return comparator(values[i], target) > 0 })
+	if index > 0 && comparator(values[index-1], target) == 0 {
+		return index - 1, true
+	}
+	return index, false
+}
+
+// PartialSort rearranges values (in-place) with respect to the given comparator,
+// so that the first k of them are the k smallest values in sorted order, while the order of the remaining values is unspecified.
+//
+// The k smallest values are selected with a binary max heap of size k within the slice, which takes O(n log k) time,
+// rather than the O(n log n) time of sorting all values.
+func PartialSort[T any](values []T, k int, comparator Comparator) {
+	k = max(0, min(k, len(values)))
+	compare, swap := maxHeap(values, comparator)
+	for index := k/2 - 1; index >= 0; index-- {
+		binaryheap.SiftDown(index, k, compare, swap)
+	}
+	for i := k; i < len(values); i++ {
+		if comparator(values[i], values[0]) < 0 {
+			swap(0, i)
+			binaryheap.SiftDown(0, k, compare, swap)
+		}
+	}
+	for end := k - 1; end > 0; end-- {
+		swap(0, end)
+		binaryheap.SiftDown(0, end, compare, swap)
+	}
+}
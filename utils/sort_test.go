@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"fmt"
 	"math/rand"
+	"sort"
 	"testing"
 )
 
@@ -88,6 +90,94 @@ func TestSortRandom(t *testing.T) {
 	}
 }
 
+func TestSortStable(t *testing.T) {
+	type pair struct {
+		key   int
+		order int
+	}
+	byKey := func(a, b any) int { return IntComparator(a.(pair).key, b.(pair).key) }
+	pairs := []pair{}
+	for i := 0; i < 1000; i++ {
+		pairs = append(pairs, pair{key: rand.Intn(10), order: i})
+	}
+	SortStable(pairs, byKey)
+	for i := 1; i < len(pairs); i++ {
+		if pairs[i-1].key > pairs[i].key || (pairs[i-1].key == pairs[i].key && pairs[i-1].order > pairs[i].order) {
+			t.Fatalf("Got %v before %v", pairs[i-1], pairs[i])
+		}
+	}
+	if actualValue := IsSorted(pairs, byKey); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestIsSorted(t *testing.T) {
+	tests := []struct {
+		values   []int
+		expected bool
+	}{
+		{[]int{}, true},
+		{[]int{1}, true},
+		{[]int{1, 1, 2}, true},
+		{[]int{2, 1}, false},
+		{[]int{1, 3, 2}, false},
+	}
+	for _, test := range tests {
+		if actualValue := IsSorted(test.values, IntComparator); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for %v", actualValue, test.expected, test.values)
+		}
+	}
+}
+
+func TestSearchFirstLast(t *testing.T) {
+	values := []int{1, 3, 3, 3, 5, 7, 7}
+	tests := []struct {
+		target                int
+		first, last           int
+		foundFirst, foundLast bool
+	}{
+		{3, 1, 3, true, true},
+		{7, 5, 6, true, true},
+		{1, 0, 0, true, true},
+		{0, 0, 0, false, false},
+		{4, 4, 4, false, false},
+		{8, 7, 7, false, false},
+	}
+	for _, test := range tests {
+		if index, found := SearchFirst(values, test.target, IntComparator); index != test.first || found != test.foundFirst {
+			t.Errorf("Got %v %v expected %v %v for %v", index, found, test.first, test.foundFirst, test.target)
+		}
+		if index, found := SearchLast(values, test.target, IntComparator); index != test.last || found != test.foundLast {
+			t.Errorf("Got %v %v expected %v %v for %v", index, found, test.last, test.foundLast, test.target)
+		}
+	}
+	if index, found := SearchFirst([]int{}, 1, IntComparator); index != 0 || found {
+		t.Errorf("Got %v %v expected %v %v", index, found, 0, false)
+	}
+}
+
+func TestPartialSort(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		values := make([]int, rand.Intn(50))
+		for j := range values {
+			values[j] = rand.Intn(20)
+		}
+		sorted := append([]int{}, values...)
+		sort.Ints(sorted)
+		k := rand.Intn(60) - 5
+		PartialSort(values, k, IntComparator)
+		k = max(0, min(k, len(values)))
+		if actualValue, expectedValue := fmt.Sprint(values[:k]), fmt.Sprint(sorted[:k]); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+		rest := append([]int{}, values[k:]...)
+		sort.Ints(rest)
+		if actualValue, expectedValue := fmt.Sprint(rest), fmt.Sprint(sorted[k:]); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
 func BenchmarkGoSortRandom(b *testing.B) {
 	b.StopTimer()
 	ints := []any{}
@@ -98,3 +188,14 @@ func BenchmarkGoSortRandom(b *testing.B) {
 	Sort(ints, IntComparator)
 	b.StopTimer()
 }
+
+func BenchmarkGoSortStableRandom(b *testing.B) {
+	b.StopTimer()
+	ints := []any{}
+	for i := 0; i < 100000; i++ {
+		ints = append(ints, rand.Int())
+	}
+	b.StartTimer()
+	SortStable(ints, IntComparator)
+	b.StopTimer()
+}
@@ -3,6 +3,7 @@
 // Provided functionalities:
 // - sorting
 // - comparators
+// - top-k selection and streaming median
 package utils
 
 import (